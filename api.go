@@ -2,6 +2,7 @@ package goex
 
 import (
 	"github.com/nntaoli-project/goex/v2/model"
+	"time"
 )
 
 // IPubRest is a public interface that does not require authorization."
@@ -10,6 +11,8 @@ type IPubRest interface {
 	GetDepth(pair model.CurrencyPair, limit int, opt ...model.OptionParameter) (depth *model.Depth, responseBody []byte, err error)
 	GetTicker(pair model.CurrencyPair, opt ...model.OptionParameter) (ticker *model.Ticker, responseBody []byte, err error)
 	GetKline(pair model.CurrencyPair, period model.KlinePeriod, opt ...model.OptionParameter) (klines []model.Kline, responseBody []byte, err error)
+	//GetTrades 获取最近的公共成交记录,Trade.Side为主动成交(taker)方向
+	GetTrades(pair model.CurrencyPair, limit int, opt ...model.OptionParameter) (trades []model.Trade, responseBody []byte, err error)
 	GetExchangeInfo() (map[string]model.CurrencyPair, []byte, error)
 	// NewCurrencyPair 同时支持现货和期货
 	//@parameter
@@ -19,7 +22,7 @@ type IPubRest interface {
 	NewCurrencyPair(baseSym, quoteSym string, opts ...model.OptionParameter) (model.CurrencyPair, error)
 }
 
-//IPrvRest is a private interface specification that requires authorization to call.
+// IPrvRest is a private interface specification that requires authorization to call.
 type IPrvRest interface {
 	GetAccount(coin string) (map[string]model.Account, []byte, error)
 	//CreateOrder
@@ -34,6 +37,131 @@ type IPrvRest interface {
 	CancelOrder(pair model.CurrencyPair, id string, opt ...model.OptionParameter) (responseBody []byte, err error)
 }
 
+// IBatchPrvRest 批量下单/撤单,交易所支持时可选实现
+type IBatchPrvRest interface {
+	//CreateOrders 批量下单
+	//@parameter
+	//  orders 使用Order的Pair,Qty,Price,Side,OrderTy,CId字段作为下单参数
+	//@returns
+	//  results      与orders顺序一一对应的下单结果
+	//  responseBody 交易所接口返回的原始字节数据
+	//  err          整个请求失败时的错误,单个订单的错误在results中
+	CreateOrders(orders []model.Order, opt ...model.OptionParameter) (results []model.BatchOrderResult, responseBody []byte, err error)
+	//CancelOrders 批量撤单,results与ids顺序一一对应
+	CancelOrders(pair model.CurrencyPair, ids []string, opt ...model.OptionParameter) (results []model.BatchOrderResult, responseBody []byte, err error)
+}
+
+// IAmendPrvRest 修改订单,交易所支持时可选实现
+type IAmendPrvRest interface {
+	//AmendOrder 修改未完成订单的数量和价格,保留订单的排队优先级(交易所支持的情况下)
+	//@parameter
+	//  newQty   新的委托数量,为0时不修改
+	//  newPrice 新的委托价格,为0时不修改
+	//@returns
+	//  order 修改后的订单,部分交易所会生成新的订单ID
+	AmendOrder(pair model.CurrencyPair, id string, newQty, newPrice float64, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error)
+}
+
+// ICancelAllPrvRest 撤销交易对的全部挂单
+type ICancelAllPrvRest interface {
+	CancelAllOrders(pair model.CurrencyPair, opt ...model.OptionParameter) (responseBody []byte, err error)
+}
+
+// IDeadManSwitchPrvRest 倒计时全部撤单(dead man's switch),程序异常退出后挂单会在倒计时结束时被撤销
+type IDeadManSwitchPrvRest interface {
+	//CancelAllAfter 设置timeout后撤销全部挂单,需要在倒计时结束前重复调用以续期
+	//@parameter
+	//  pair    部分交易所(okx)按账户生效,会忽略该参数
+	//  timeout 为0时取消倒计时
+	CancelAllAfter(pair model.CurrencyPair, timeout time.Duration, opt ...model.OptionParameter) (responseBody []byte, err error)
+}
+
+// IFillsPrvRest 查询成交明细
+type IFillsPrvRest interface {
+	//GetFills 获取最近的成交明细,可以通过opt传入订单ID、分页等交易所参数
+	GetFills(pair model.CurrencyPair, opt ...model.OptionParameter) (trades []model.Trade, responseBody []byte, err error)
+}
+
+// IFuturesLeveragePrvRest 合约杠杆倍数、保证金模式及持仓模式设置
+type IFuturesLeveragePrvRest interface {
+	SetLeverage(pair model.CurrencyPair, lever float64, opt ...model.OptionParameter) (responseBody []byte, err error)
+	GetLeverage(pair model.CurrencyPair, opt ...model.OptionParameter) (lever float64, responseBody []byte, err error)
+	//SetMarginMode 部分交易所的保证金模式在下单时指定或者只支持一种模式,这时只修改本地设置,不发送请求,responseBody为nil
+	SetMarginMode(pair model.CurrencyPair, mode model.MarginMode, opt ...model.OptionParameter) (responseBody []byte, err error)
+	//SetPositionMode 设置单向/双向持仓,一般按账户生效,需要在没有持仓和挂单时设置
+	SetPositionMode(mode model.PositionMode, opt ...model.OptionParameter) (responseBody []byte, err error)
+	//GetPositionMode 查询账户当前的持仓模式
+	GetPositionMode(opt ...model.OptionParameter) (mode model.PositionMode, responseBody []byte, err error)
+}
+
+// IFuturesPubRest 永续合约的公共数据接口
+type IFuturesPubRest interface {
+	//GetFundingRate 获取当前资金费率及下一期资金费时间
+	GetFundingRate(pair model.CurrencyPair, opt ...model.OptionParameter) (rate *model.FundingRate, responseBody []byte, err error)
+	//GetFundingRateHistory 获取历史资金费率,和IFuturesStatsRest一样按FundingTime升序(旧到新)返回
+	GetFundingRateHistory(pair model.CurrencyPair, limit int, opt ...model.OptionParameter) (rates []model.FundingRate, responseBody []byte, err error)
+	GetMarkPrice(pair model.CurrencyPair, opt ...model.OptionParameter) (price *model.MarkPrice, responseBody []byte, err error)
+	GetIndexPrice(pair model.CurrencyPair, opt ...model.OptionParameter) (price *model.IndexPrice, responseBody []byte, err error)
+}
+
+// IFuturesStatsRest 合约市场统计数据,返回按Timestamp升序(旧到新)的时间序列,limit大于0时最多返回最近的limit条
+type IFuturesStatsRest interface {
+	GetOpenInterestHistory(pair model.CurrencyPair, period model.KlinePeriod, limit int, opt ...model.OptionParameter) (data []model.OpenInterest, responseBody []byte, err error)
+	//GetLongShortRatio 多空账户数比,各交易所统计口径不同(全部账户/精英账户)
+	GetLongShortRatio(pair model.CurrencyPair, period model.KlinePeriod, limit int, opt ...model.OptionParameter) (data []model.LongShortRatio, responseBody []byte, err error)
+	GetTakerVolume(pair model.CurrencyPair, period model.KlinePeriod, limit int, opt ...model.OptionParameter) (data []model.TakerVolume, responseBody []byte, err error)
+}
+
+// ITransferPrvRest 同一用户不同账户之间的资金划转
+type ITransferPrvRest interface {
+	//Transfer 资金划转
+	//@returns
+	//  id 划转ID
+	Transfer(coin string, amount float64, from, to model.AccountType, opt ...model.OptionParameter) (id string, responseBody []byte, err error)
+	//GetTransferHistory 获取from到to的划转记录,coin为空时查询全部币种
+	//  huobi没有按划转方向查询的接口,不支持,直接返回错误
+	GetTransferHistory(coin string, from, to model.AccountType, opt ...model.OptionParameter) (records []model.TransferRecord, responseBody []byte, err error)
+}
+
+// IWalletPrvRest 充值和提币,提币需要通过options.WithWithdrawGuard设置安全检查
+type IWalletPrvRest interface {
+	//GetCoinNetworks 获取币种支持的充提网络及手续费
+	GetCoinNetworks(coin string, opt ...model.OptionParameter) (networks []model.CoinNetwork, responseBody []byte, err error)
+	//GetDepositAddress network为空时返回全部网络的地址
+	GetDepositAddress(coin, network string, opt ...model.OptionParameter) (addresses []model.DepositAddress, responseBody []byte, err error)
+	GetDepositHistory(coin string, opt ...model.OptionParameter) (records []model.WalletRecord, responseBody []byte, err error)
+	GetWithdrawHistory(coin string, opt ...model.OptionParameter) (records []model.WalletRecord, responseBody []byte, err error)
+	//Withdraw 提币
+	//@returns
+	//  id 提币ID,可用于CancelWithdraw
+	Withdraw(req model.WithdrawRequest, opt ...model.OptionParameter) (id string, responseBody []byte, err error)
+	CancelWithdraw(id string, opt ...model.OptionParameter) (responseBody []byte, err error)
+}
+
+// ISubAccountPrvRest 子账户管理,需要使用母账户的API Key
+type ISubAccountPrvRest interface {
+	GetSubAccounts(opt ...model.OptionParameter) (subAccounts []model.SubAccount, responseBody []byte, err error)
+	//GetSubAccountBalance 获取子账户交易账户(现货)余额
+	GetSubAccountBalance(subAccount string, opt ...model.OptionParameter) (balances map[string]model.Account, responseBody []byte, err error)
+	//SubAccountTransfer 母子账户之间划转,fromSub/toSub为空时表示母账户
+	SubAccountTransfer(coin string, amount float64, fromSub, toSub string, opt ...model.OptionParameter) (id string, responseBody []byte, err error)
+	//CreateSubAccountApiKey 为子账户创建API Key
+	//@parameter
+	//  canTrade 为false时只有读取权限
+	//  ips      绑定的IP白名单
+	CreateSubAccountApiKey(subAccount, label string, canTrade bool, ips []string, opt ...model.OptionParameter) (apiKey *model.SubAccountApiKey, responseBody []byte, err error)
+}
+
+// IBillPrvRest 账户流水,按时间倒序返回,分页通过opt传入交易所的分页参数
+type IBillPrvRest interface {
+	GetBills(coin string, opt ...model.OptionParameter) (bills []model.Bill, responseBody []byte, err error)
+}
+
+// ITradeFeePrvRest 查询账户当前的maker/taker手续费率
+type ITradeFeePrvRest interface {
+	GetTradeFee(pair model.CurrencyPair, opt ...model.OptionParameter) (fee *model.TradeFee, responseBody []byte, err error)
+}
+
 type ISpotPrvRest interface {
 	IPrvRest
 }
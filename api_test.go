@@ -0,0 +1,18 @@
+package goex
+
+import (
+	huobispot "github.com/nntaoli-project/goex/v2/huobi/spot"
+	"github.com/nntaoli-project/goex/v2/okx/futures"
+	"github.com/nntaoli-project/goex/v2/okx/option"
+	"github.com/nntaoli-project/goex/v2/okx/spot"
+)
+
+// okx的各个市场都需要实现IPubRest
+var (
+	_ IPubRest = (*spot.Spot)(nil)
+	_ IPubRest = (*futures.Futures)(nil)
+	_ IPubRest = (*futures.Swap)(nil)
+	_ IPubRest = (*option.Option)(nil)
+)
+
+var _ IFillsPrvRest = (*huobispot.PrvApi)(nil)
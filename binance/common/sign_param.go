@@ -2,16 +2,41 @@ package common
 
 import (
 	"fmt"
+	"github.com/nntaoli-project/goex/v2/options"
 	"github.com/nntaoli-project/goex/v2/util"
 	"net/url"
-	"time"
 )
 
-func SignParams(params *url.Values, secret string) {
-	timestamp := time.Now().UnixMilli()
+// SignParams 使用apiOpts的时钟生成timestamp,设置了RecvWindow时带上recvWindow参数
+// 按apiOpts.SignMethod选择签名算法,RSA和Ed25519的secret为PEM格式的私钥
+func SignParams(params *url.Values, secret []byte, apiOpts options.ApiOptions) error {
+	timestamp := apiOpts.Now().UnixMilli()
 	params.Set("timestamp", fmt.Sprint(timestamp))
-	//params.Set("recvWindow", "60000")
+	if apiOpts.RecvWindow > 0 {
+		params.Set("recvWindow", fmt.Sprint(apiOpts.RecvWindow))
+	}
 	payload := params.Encode()
-	sign, _ := util.HmacSHA256Sign(secret, payload)
+
+	var (
+		sign string
+		err  error
+	)
+
+	switch apiOpts.SignMethod {
+	case options.SignMethod_RSA:
+		sign, err = util.RsaSHA256Base64Sign(secret, payload)
+	case options.SignMethod_Ed25519:
+		sign, err = util.Ed25519Base64Sign(secret, payload)
+	case "", options.SignMethod_HmacSHA256:
+		sign, err = util.HmacSHA256SignBytes(secret, payload)
+	default:
+		err = fmt.Errorf("binance not support sign method %s", apiOpts.SignMethod)
+	}
+	if err != nil {
+		return err
+	}
+
 	params.Set("signature", sign)
+
+	return nil
 }
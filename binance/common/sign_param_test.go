@@ -0,0 +1,154 @@
+package common
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"github.com/nntaoli-project/goex/v2/options"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestSignParams(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPkcs8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPkcs8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hmacSecret := []byte("hmac-secret")
+
+	verifyRsa := func(payload string, sign []byte) error {
+		hashed := sha256.Sum256([]byte(payload))
+		return rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, hashed[:], sign)
+	}
+
+	tests := []struct {
+		name    string
+		method  options.SignMethod
+		secret  []byte
+		decode  func(string) ([]byte, error)
+		verify  func(payload string, sign []byte) error
+		wantErr bool
+	}{
+		{
+			name:   "hmac default",
+			method: "",
+			secret: hmacSecret,
+			decode: hex.DecodeString,
+			verify: func(payload string, sign []byte) error {
+				mac := hmac.New(sha256.New, hmacSecret)
+				mac.Write([]byte(payload))
+				if !hmac.Equal(mac.Sum(nil), sign) {
+					return errors.New("hmac signature mismatch")
+				}
+				return nil
+			},
+		},
+		{
+			name:   "rsa pkcs1",
+			method: options.SignMethod_RSA,
+			secret: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			decode: base64.StdEncoding.DecodeString,
+			verify: verifyRsa,
+		},
+		{
+			name:   "rsa pkcs8",
+			method: options.SignMethod_RSA,
+			secret: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rsaPkcs8}),
+			decode: base64.StdEncoding.DecodeString,
+			verify: verifyRsa,
+		},
+		{
+			name:   "ed25519",
+			method: options.SignMethod_Ed25519,
+			secret: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edPkcs8}),
+			decode: base64.StdEncoding.DecodeString,
+			verify: func(payload string, sign []byte) error {
+				if !ed25519.Verify(edPub, []byte(payload), sign) {
+					return errors.New("ed25519 signature mismatch")
+				}
+				return nil
+			},
+		},
+		{
+			name:    "ed25519 with rsa key",
+			method:  options.SignMethod_Ed25519,
+			secret:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rsaPkcs8}),
+			wantErr: true,
+		},
+		{
+			name:    "unknown sign method",
+			method:  "SM2",
+			secret:  hmacSecret,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiOpts := options.ApiOptions{
+				SignMethod: tt.method,
+				RecvWindow: 5000,
+				Clock:      fixedClock(time.UnixMilli(1672531200000)),
+			}
+			params := url.Values{}
+			params.Set("symbol", "BTCUSDT")
+			params.Set("side", "BUY")
+
+			err := SignParams(&params, tt.secret, apiOpts)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if params.Get("signature") != "" {
+					t.Fatal("signature should not be set on error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if params.Get("timestamp") != "1672531200000" || params.Get("recvWindow") != "5000" {
+				t.Fatalf("unexpected timestamp or recvWindow: %s", params.Encode())
+			}
+
+			sign, err := tt.decode(params.Get("signature"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			params.Del("signature")
+			if err = tt.verify(params.Encode(), sign); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package futures
+
+import (
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+)
+
+// adaptOrderSide 转换买卖方向及持仓方向,单向持仓模式positionSide为BOTH,平仓单使用reduceOnly
+func adaptOrderSide(s model.OrderSide, posMode model.PositionMode) (side, positionSide string, reduceOnly bool) {
+	if posMode == model.PositionMode_OneWay {
+		switch s {
+		case model.Futures_OpenBuy:
+			return "BUY", "BOTH", false
+		case model.Futures_OpenSell:
+			return "SELL", "BOTH", false
+		case model.Futures_CloseBuy:
+			return "SELL", "BOTH", true
+		case model.Futures_CloseSell:
+			return "BUY", "BOTH", true
+		default:
+			logger.Warnf("[adapt side] order side:%s error", s)
+		}
+		return string(s), "BOTH", false
+	}
+
+	switch s {
+	case model.Futures_OpenBuy:
+		return "BUY", "LONG", false
+	case model.Futures_OpenSell:
+		return "SELL", "SHORT", false
+	case model.Futures_CloseBuy:
+		return "SELL", "LONG", false
+	case model.Futures_CloseSell:
+		return "BUY", "SHORT", false
+	default:
+		logger.Warnf("[adapt side] order side:%s error", s)
+	}
+	return string(s), "", false
+}
+
+func adaptOrderType(ty model.OrderType) string {
+	switch ty {
+	case model.OrderType_Limit:
+		return "LIMIT"
+	case model.OrderType_Market:
+		return "MARKET"
+	default:
+		logger.Warnf("[adapt order type] order typ unknown")
+	}
+	return string(ty)
+}
+
+// adaptOrderOrigSide 单向持仓(positionSide=BOTH)时根据reduceOnly区分开平仓
+func adaptOrderOrigSide(side, positionSide string, reduceOnly bool) model.OrderSide {
+	switch side {
+	case "BUY":
+		if positionSide == "SHORT" || (positionSide == "BOTH" && reduceOnly) {
+			return model.Futures_CloseSell
+		}
+		return model.Futures_OpenBuy
+	case "SELL":
+		if positionSide == "LONG" || (positionSide == "BOTH" && reduceOnly) {
+			return model.Futures_CloseBuy
+		}
+		return model.Futures_OpenSell
+	default:
+		logger.Warnf("[adaptOrderOrigSide] unknown order origin side: %s", side)
+	}
+	return model.OrderSide(side)
+}
+
+func adaptOrderOrigType(ty string) model.OrderType {
+	switch ty {
+	case "LIMIT":
+		return model.OrderType_Limit
+	case "MARKET":
+		return model.OrderType_Market
+	default:
+		return model.OrderType(ty)
+	}
+}
+
+func adaptOrderStatus(st string) model.OrderStatus {
+	switch st {
+	case "NEW":
+		return model.OrderStatus_Pending
+	case "FILLED":
+		return model.OrderStatus_Finished
+	case "CANCELED", "EXPIRED":
+		return model.OrderStatus_Canceled
+	case "PARTIALLY_FILLED":
+		return model.OrderStatus_PartFinished
+	}
+	return model.OrderStatus(-1)
+}
+
+func adaptKlinePeriod(period model.KlinePeriod) string {
+	switch period {
+	case model.Kline_1min:
+		return "1m"
+	case model.Kline_5min:
+		return "5m"
+	case model.Kline_15min:
+		return "15m"
+	case model.Kline_30min:
+		return "30m"
+	case model.Kline_1h, model.Kline_60min:
+		return "1h"
+	case model.Kline_4h:
+		return "4h"
+	case model.Kline_6h:
+		return "6h"
+	case model.Kline_1day:
+		return "1d"
+	case model.Kline_1week:
+		return "1w"
+	}
+	return string(period)
+}
+
+func adaptIncomeType(ty string) model.BillType {
+	switch ty {
+	case "REALIZED_PNL":
+		return model.BillType_Trade
+	case "COMMISSION":
+		return model.BillType_Fee
+	case "FUNDING_FEE":
+		return model.BillType_Funding
+	case "TRANSFER", "INTERNAL_TRANSFER":
+		return model.BillType_Transfer
+	case "INSURANCE_CLEAR":
+		return model.BillType_Liquidation
+	}
+	return model.BillType_Other
+}
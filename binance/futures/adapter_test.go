@@ -0,0 +1,40 @@
+package futures
+
+import (
+	"github.com/nntaoli-project/goex/v2/model"
+	"testing"
+)
+
+func TestAdaptOrderSide(t *testing.T) {
+	tests := []struct {
+		side             model.OrderSide
+		posMode          model.PositionMode
+		wantSide         string
+		wantPositionSide string
+		wantReduceOnly   bool
+	}{
+		{model.Futures_OpenBuy, model.PositionMode_OneWay, "BUY", "BOTH", false},
+		{model.Futures_OpenSell, model.PositionMode_OneWay, "SELL", "BOTH", false},
+		{model.Futures_CloseBuy, model.PositionMode_OneWay, "SELL", "BOTH", true},
+		{model.Futures_CloseSell, model.PositionMode_OneWay, "BUY", "BOTH", true},
+
+		{model.Futures_OpenBuy, model.PositionMode_Hedge, "BUY", "LONG", false},
+		{model.Futures_OpenSell, model.PositionMode_Hedge, "SELL", "SHORT", false},
+		{model.Futures_CloseBuy, model.PositionMode_Hedge, "SELL", "LONG", false},
+		{model.Futures_CloseSell, model.PositionMode_Hedge, "BUY", "SHORT", false},
+
+		//不支持的方向原样返回
+		{model.Spot_Buy, model.PositionMode_OneWay, "buy", "BOTH", false},
+		{model.Spot_Buy, model.PositionMode_Hedge, "buy", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.side)+"/"+string(tt.posMode), func(t *testing.T) {
+			side, positionSide, reduceOnly := adaptOrderSide(tt.side, tt.posMode)
+			if side != tt.wantSide || positionSide != tt.wantPositionSide || reduceOnly != tt.wantReduceOnly {
+				t.Fatalf("got (%s, %s, %v), want (%s, %s, %v)",
+					side, positionSide, reduceOnly, tt.wantSide, tt.wantPositionSide, tt.wantReduceOnly)
+			}
+		})
+	}
+}
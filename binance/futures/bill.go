@@ -0,0 +1,38 @@
+package futures
+
+import (
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// GetBills 资金流水(income),默认返回最近7天的1000条,分页时通过opts传入startTime/endTime/page
+// 交易所的income接口没有asset参数,coin不为空时在本地过滤当前页,返回的条数可能少于1000甚至为空,
+// 不能据此判断是否还有下一页;需要服务端过滤时可以通过opts传入symbol、incomeType
+func (f *PrvApi) GetBills(coin string, opts ...OptionParameter) ([]Bill, []byte, error) {
+	params := url.Values{}
+	params.Set("limit", "1000")
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetBillsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	bills, err := f.UnmarshalerOpts.GetBillsResponseUnmarshaler(data)
+	if err != nil || coin == "" {
+		return bills, data, err
+	}
+
+	filtered := make([]Bill, 0, len(bills))
+	for _, b := range bills {
+		if b.Coin == coin {
+			filtered = append(filtered, b)
+		}
+	}
+
+	return filtered, data, nil
+}
@@ -0,0 +1,109 @@
+package futures
+
+import (
+	"github.com/nntaoli-project/goex/v2/httpcli"
+	. "github.com/nntaoli-project/goex/v2/options"
+)
+
+type Futures struct {
+	USDTFutures *USDTFutures
+}
+
+// USDTFutures U本位合约
+type USDTFutures struct {
+	UriOpts         UriOptions
+	UnmarshalerOpts UnmarshalerOptions
+	ExOpts          ExchangeOptions
+}
+
+func New(opts ...ExchangeOption) *Futures {
+	return &Futures{
+		USDTFutures: NewUSDTFutures(opts...),
+	}
+}
+
+func NewUSDTFutures(opts ...ExchangeOption) *USDTFutures {
+	unmarshaler := new(RespUnmarshaler)
+	f := &USDTFutures{
+		ExOpts: NewExchangeOptions(opts...),
+		UriOpts: UriOptions{
+			Endpoint:                  "https://fapi.binance.com",
+			GetServerTimeUri:          "/fapi/v1/time",
+			NewOrderUri:               "/fapi/v1/order",
+			GetOrderUri:               "/fapi/v1/order",
+			GetPendingOrdersUri:       "/fapi/v1/openOrders",
+			GetHistoryOrdersUri:       "/fapi/v1/allOrders",
+			CancelOrderUri:            "/fapi/v1/order",
+			NewBatchOrdersUri:         "/fapi/v1/batchOrders",
+			CancelBatchOrdersUri:      "/fapi/v1/batchOrders",
+			AmendOrderUri:             "/fapi/v1/order",
+			CancelAllOrdersUri:        "/fapi/v1/allOpenOrders",
+			CancelAllAfterUri:         "/fapi/v1/countdownCancelAll",
+			GetFillsUri:               "/fapi/v1/userTrades",
+			GetTradeFeeUri:            "/fapi/v1/commissionRate",
+			GetFundingRateUri:         "/fapi/v1/premiumIndex",
+			GetFundingRateHistoryUri:  "/fapi/v1/fundingRate",
+			GetMarkPriceUri:           "/fapi/v1/premiumIndex",
+			GetIndexPriceUri:          "/fapi/v1/premiumIndex",
+			GetOpenInterestHistoryUri: "/futures/data/openInterestHist",
+			GetLongShortRatioUri:      "/futures/data/globalLongShortAccountRatio",
+			GetTakerVolumeUri:         "/futures/data/takerlongshortRatio",
+			SetLeverageUri:            "/fapi/v1/leverage",
+			GetLeverageUri:            "/fapi/v2/positionRisk",
+			SetMarginModeUri:          "/fapi/v1/marginType",
+			SetPositionModeUri:        "/fapi/v1/positionSide/dual",
+			GetPositionModeUri:        "/fapi/v1/positionSide/dual",
+			GetBillsUri:               "/fapi/v1/income",
+		},
+		UnmarshalerOpts: UnmarshalerOptions{
+			ResponseUnmarshaler:                       unmarshaler.UnmarshalResponse,
+			GetServerTimeResponseUnmarshaler:          unmarshaler.UnmarshalGetServerTimeResponse,
+			GetOrderInfoResponseUnmarshaler:           unmarshaler.UnmarshalGetOrderInfoResponse,
+			AmendOrderResponseUnmarshaler:             unmarshaler.UnmarshalGetOrderInfoResponse,
+			CreateOrdersResponseUnmarshaler:           unmarshaler.UnmarshalCreateOrdersResponse,
+			CancelOrdersResponseUnmarshaler:           unmarshaler.UnmarshalCancelOrdersResponse,
+			GetFillsResponseUnmarshaler:               unmarshaler.UnmarshalGetFillsResponse,
+			GetTradeFeeResponseUnmarshaler:            unmarshaler.UnmarshalGetTradeFeeResponse,
+			GetPositionModeResponseUnmarshaler:        unmarshaler.UnmarshalGetPositionModeResponse,
+			GetFundingRateResponseUnmarshaler:         unmarshaler.UnmarshalGetFundingRateResponse,
+			GetFundingRateHistoryResponseUnmarshaler:  unmarshaler.UnmarshalGetFundingRateHistoryResponse,
+			GetMarkPriceResponseUnmarshaler:           unmarshaler.UnmarshalGetMarkPriceResponse,
+			GetIndexPriceResponseUnmarshaler:          unmarshaler.UnmarshalGetIndexPriceResponse,
+			GetOpenInterestHistoryResponseUnmarshaler: unmarshaler.UnmarshalGetOpenInterestHistoryResponse,
+			GetLongShortRatioResponseUnmarshaler:      unmarshaler.UnmarshalGetLongShortRatioResponse,
+			GetBillsResponseUnmarshaler:               unmarshaler.UnmarshalGetBillsResponse,
+			GetTakerVolumeResponseUnmarshaler:         unmarshaler.UnmarshalGetTakerVolumeResponse,
+			GetLeverageResponseUnmarshaler:            unmarshaler.UnmarshalGetLeverageResponse,
+		},
+	}
+
+	if f.ExOpts.IsSandbox() {
+		f.UriOpts.Endpoint = "https://testnet.binancefuture.com"
+	} else {
+		f.UriOpts.EndpointPool = httpcli.NewEndpointPool(f.ExOpts.Logger, f.UriOpts.GetServerTimeUri, f.UriOpts.Endpoint,
+			"https://fapi1.binance.com", "https://fapi2.binance.com", "https://fapi3.binance.com")
+		f.UriOpts.EndpointPool.Start(f.ExOpts.EndpointProbeInterval)
+	}
+
+	return f
+}
+
+func (f *USDTFutures) WithUriOption(uriOpts ...UriOption) *USDTFutures {
+	for _, opt := range uriOpts {
+		opt(&f.UriOpts)
+	}
+	return f
+}
+
+func (f *USDTFutures) WithUnmarshalerOptions(opts ...UnmarshalerOption) *USDTFutures {
+	for _, opt := range opts {
+		opt(&f.UnmarshalerOpts)
+	}
+	return f
+}
+
+func (f *USDTFutures) NewPrvApi(apiOpts ...ApiOption) *PrvApi {
+	prv := NewPrvApi(apiOpts...)
+	prv.USDTFutures = f
+	return prv
+}
@@ -0,0 +1,123 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SetLeverage 只支持整数倍杠杆
+func (f *PrvApi) SetLeverage(pair CurrencyPair, lever float64, opts ...OptionParameter) ([]byte, error) {
+	if lever <= 0 || lever != math.Trunc(lever) {
+		return nil, fmt.Errorf("lever %v error, binance futures only support integer lever", lever)
+	}
+
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("leverage", fmt.Sprint(int(lever)))
+	MergeOptionParams(&params, opts...)
+
+	return f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.SetLeverageUri), &params, nil)
+}
+
+// GetLeverage 从positionRisk接口获取交易对当前的杠杆倍数
+func (f *PrvApi) GetLeverage(pair CurrencyPair, opts ...OptionParameter) (float64, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetLeverageUri), &params, nil)
+	if err != nil {
+		return 0, data, err
+	}
+
+	lever, err := f.UnmarshalerOpts.GetLeverageResponseUnmarshaler(data)
+	return lever, data, err
+}
+
+// SetMarginMode 保证金模式没有变化时(code=-4046)不返回错误
+func (f *PrvApi) SetMarginMode(pair CurrencyPair, mode MarginMode, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	switch mode {
+	case MarginMode_Cross:
+		params.Set("marginType", "CROSSED")
+	case MarginMode_Isolated:
+		params.Set("marginType", "ISOLATED")
+	default:
+		return nil, errors.New("margin mode only is MarginMode_Cross or MarginMode_Isolated")
+	}
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.SetMarginModeUri), &params, nil)
+	if err != nil && strings.Contains(string(data), "-4046") {
+		return data, nil
+	}
+
+	return data, err
+}
+
+// SetPositionMode 按账户生效,持仓模式没有变化时(code=-4059)不返回错误,设置成功后下单按对应的模式转换买卖方向
+func (f *PrvApi) SetPositionMode(mode PositionMode, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	switch mode {
+	case PositionMode_Hedge:
+		params.Set("dualSidePosition", "true")
+	case PositionMode_OneWay:
+		params.Set("dualSidePosition", "false")
+	default:
+		return nil, errors.New("position mode only is PositionMode_Hedge or PositionMode_OneWay")
+	}
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.SetPositionModeUri), &params, nil)
+	if err != nil && !strings.Contains(string(data), "-4059") {
+		return data, err
+	}
+
+	f.posModeMu.Lock()
+	f.posMode = mode
+	f.posModeMu.Unlock()
+
+	return data, nil
+}
+
+// GetPositionMode 查询账户的持仓模式
+func (f *PrvApi) GetPositionMode(opts ...OptionParameter) (PositionMode, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetPositionModeUri), &params, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	mode, err := f.UnmarshalerOpts.GetPositionModeResponseUnmarshaler(data)
+	return mode, data, err
+}
+
+// positionMode 下单使用的持仓模式,没有设置时查询一次交易所并缓存
+func (f *PrvApi) positionMode() (PositionMode, error) {
+	f.posModeMu.Lock()
+	defer f.posModeMu.Unlock()
+
+	if f.posMode == "" {
+		mode, _, err := f.GetPositionMode()
+		if err != nil {
+			return "", err
+		}
+		f.posMode = mode
+	}
+
+	return f.posMode, nil
+}
@@ -0,0 +1,317 @@
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	. "github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/metrics"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/tracing"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+type PrvApi struct {
+	*USDTFutures
+	apiOpts options.ApiOptions
+
+	posModeMu sync.Mutex
+	posMode   PositionMode //持仓模式,通过WithPositionMode、SetPositionMode设置或者下单时查询
+}
+
+func NewPrvApi(apiOpts ...options.ApiOption) *PrvApi {
+	f := new(PrvApi)
+	for _, opt := range apiOpts {
+		opt(&f.apiOpts)
+	}
+	f.posMode = f.apiOpts.PositionMode
+	return f
+}
+
+func (f *PrvApi) GetOrderInfo(pair CurrencyPair, id string, opts ...OptionParameter) (*Order, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("orderId", id)
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetOrderUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := f.UnmarshalerOpts.GetOrderInfoResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord.Pair = pair
+
+	return ord, data, nil
+}
+
+// AmendOrder 修改限价订单,交易所要求同时传入方向、数量和价格
+// newQty、newPrice都大于0且opts中传入side(BUY/SELL)时直接修改,否则先查询原订单补全
+func (f *PrvApi) AmendOrder(pair CurrencyPair, id string, newQty, newPrice float64, opts ...OptionParameter) (*Order, []byte, error) {
+	var side string
+	for _, opt := range opts {
+		if opt.Key == "side" {
+			side = opt.Value
+		}
+	}
+
+	if newQty <= 0 || newPrice <= 0 || side == "" {
+		origOrd, data, err := f.GetOrderInfo(pair, id)
+		if err != nil {
+			return nil, data, err
+		}
+		if newQty <= 0 {
+			newQty = origOrd.Qty
+		}
+		if newPrice <= 0 {
+			newPrice = origOrd.Price
+		}
+		if side == "" {
+			side, _, _ = adaptOrderSide(origOrd.Side, PositionMode_Hedge) //side与持仓模式无关
+		}
+	}
+
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("orderId", id)
+	params.Set("side", side)
+	params.Set("quantity", FloatToString(newQty, pair.QtyPrecision))
+	params.Set("price", FloatToString(newPrice, pair.PricePrecision))
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPut,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.AmendOrderUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := f.UnmarshalerOpts.AmendOrderResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord.Pair = pair
+
+	return ord, data, nil
+}
+
+// CreateOrders 批量下单,单次最多5个订单,opts会作用于每一个订单
+func (f *PrvApi) CreateOrders(orders []Order, opts ...OptionParameter) ([]BatchOrderResult, []byte, error) {
+	posMode, err := f.positionMode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	batchOrders := make([]map[string]string, 0, len(orders))
+	for _, ord := range orders {
+		side, positionSide, reduceOnly := adaptOrderSide(ord.Side, posMode)
+		p := map[string]string{
+			"symbol":       ord.Pair.Symbol,
+			"side":         side,
+			"positionSide": positionSide,
+			"type":         adaptOrderType(ord.OrderTy),
+			"quantity":     FloatToString(ord.Qty, ord.Pair.QtyPrecision),
+		}
+		if reduceOnly {
+			p["reduceOnly"] = "true"
+		}
+		if ord.OrderTy == OrderType_Limit {
+			p["price"] = FloatToString(ord.Price, ord.Pair.PricePrecision)
+			p["timeInForce"] = "GTC"
+		}
+		if ord.CId != "" {
+			p["newClientOrderId"] = ord.CId
+		}
+		for _, opt := range opts {
+			p[opt.Key] = opt.Value
+		}
+		batchOrders = append(batchOrders, p)
+	}
+
+	batchOrdersData, err := json.Marshal(batchOrders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	params.Set("batchOrders", string(batchOrdersData))
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.NewBatchOrdersUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	results, err := f.UnmarshalerOpts.CreateOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if len(results) != len(orders) {
+		return nil, data, errors.New("the number of batch order results does not match the request")
+	}
+
+	for i := range results {
+		ord := orders[i]
+		ord.Id = results[i].Order.Id
+		ord.Status = OrderStatus_Pending
+		if results[i].Order.CId != "" {
+			ord.CId = results[i].Order.CId
+		}
+		results[i].Order = ord
+	}
+
+	return results, data, nil
+}
+
+// CancelOrders 批量撤单,单次最多10个订单
+func (f *PrvApi) CancelOrders(pair CurrencyPair, ids []string, opts ...OptionParameter) ([]BatchOrderResult, []byte, error) {
+	orderIdList, err := json.Marshal(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("orderIdList", string(orderIdList))
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodDelete,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.CancelBatchOrdersUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	results, err := f.UnmarshalerOpts.CancelOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if len(results) != len(ids) {
+		return nil, data, errors.New("the number of batch cancel results does not match the request")
+	}
+
+	for i := range results {
+		results[i].Order.Pair = pair
+		results[i].Order.Id = ids[i]
+		if results[i].Err == nil {
+			results[i].Order.Status = OrderStatus_Canceled
+		}
+	}
+
+	return results, data, nil
+}
+
+func (f *PrvApi) GetFills(pair CurrencyPair, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetFillsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	trades, err := f.UnmarshalerOpts.GetFillsResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
+func (f *PrvApi) GetTradeFee(pair CurrencyPair, opt ...OptionParameter) (*TradeFee, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opt...)
+
+	data, err := f.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetTradeFeeUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	fee, err := f.UnmarshalerOpts.GetTradeFeeResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+	fee.Pair = pair
+
+	return fee, data, nil
+}
+
+func (f *PrvApi) CancelAllOrders(pair CurrencyPair, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+	return f.DoAuthRequest(http.MethodDelete,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.CancelAllOrdersUri), &params, nil)
+}
+
+// CancelAllAfter 倒计时撤销交易对的全部挂单,timeout为0时取消倒计时
+func (f *PrvApi) CancelAllAfter(pair CurrencyPair, timeout time.Duration, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("countdownTime", fmt.Sprint(timeout.Milliseconds()))
+	MergeOptionParams(&params, opts...)
+	return f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.CancelAllAfterUri), &params, nil)
+}
+
+func (f *PrvApi) DoAuthRequest(method, reqUrl string, params *url.Values, header map[string]string) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(f.GetName(), metrics.Layer_Auth, method, reqUrl, start, err)
+		LogRequest(f.ExOpts.Logger, f.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(f.ExOpts.Context, f.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	if header == nil {
+		header = make(map[string]string, 2)
+	}
+
+	signSpan := tracing.StartStep(ctx, "sign")
+	cred, err := f.apiOpts.Credential()
+	if err != nil {
+		tracing.End(signSpan, err)
+		return nil, err
+	}
+	defer cred.Zero()
+
+	header["X-MBX-APIKEY"] = string(cred.Key)
+	err = common.SignParams(params, cred.Secret, f.apiOpts)
+	tracing.End(signSpan, err)
+	if err != nil {
+		return nil, err
+	}
+	reqUrl += "?" + params.Encode()
+	httpSpan := tracing.StartStep(ctx, "http")
+	respBody, err := f.UriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		return DoRequestContext(ctx, Cli, method, reqUrl, "", header)
+	})
+	tracing.End(httpSpan, err)
+	f.ExOpts.Logger.Debug("response", "exchange", f.GetName(), "url", reqUrl, "body", LogBody(respBody))
+	if err != nil {
+		return respBody, fmt.Errorf("%w%s", err, errors.New(string(respBody)))
+	}
+	return respBody, nil
+}
@@ -0,0 +1,152 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/metrics"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/tracing"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+func (f *USDTFutures) GetName() string {
+	return "binance.com"
+}
+
+// GetFundingRate 币安的资金费在nextFundingTime收取,FundingTime与NextFundingTime相同
+func (f *USDTFutures) GetFundingRate(pair CurrencyPair, opts ...OptionParameter) (*FundingRate, []byte, error) {
+	data, err := f.getPremiumIndex(f.UriOpts.GetFundingRateUri, pair, opts...)
+	if err != nil {
+		return nil, data, err
+	}
+
+	rate, err := f.UnmarshalerOpts.GetFundingRateResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	rate.Pair = pair
+
+	return rate, data, nil
+}
+
+func (f *USDTFutures) GetFundingRateHistory(pair CurrencyPair, limit int, opts ...OptionParameter) ([]FundingRate, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("limit", fmt.Sprint(limit))
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetFundingRateHistoryUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	rates, err := f.UnmarshalerOpts.GetFundingRateHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	//统一为按时间升序
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].FundingTime < rates[j].FundingTime
+	})
+
+	for i := range rates {
+		rates[i].Pair = pair
+	}
+
+	return rates, data, nil
+}
+
+func (f *USDTFutures) GetMarkPrice(pair CurrencyPair, opts ...OptionParameter) (*MarkPrice, []byte, error) {
+	data, err := f.getPremiumIndex(f.UriOpts.GetMarkPriceUri, pair, opts...)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price, err := f.UnmarshalerOpts.GetMarkPriceResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price.Pair = pair
+
+	return price, data, nil
+}
+
+func (f *USDTFutures) GetIndexPrice(pair CurrencyPair, opts ...OptionParameter) (*IndexPrice, []byte, error) {
+	data, err := f.getPremiumIndex(f.UriOpts.GetIndexPriceUri, pair, opts...)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price, err := f.UnmarshalerOpts.GetIndexPriceResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price.Pair = pair
+
+	return price, data, nil
+}
+
+// getPremiumIndex 资金费率、标记价格、指数价格都来自premiumIndex接口
+func (f *USDTFutures) getPremiumIndex(uri string, pair CurrencyPair, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	return f.DoNoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", f.UriOpts.Endpoint, uri), &params, nil)
+}
+
+// GetServerTime 服务器时间(毫秒)
+func (f *USDTFutures) GetServerTime(opts ...OptionParameter) (int64, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	respBody, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.UriOpts.Endpoint, f.UriOpts.GetServerTimeUri), &params, nil)
+	if err != nil {
+		return 0, respBody, err
+	}
+
+	ts, err := f.UnmarshalerOpts.GetServerTimeResponseUnmarshaler(respBody)
+	return ts, respBody, err
+}
+
+func (f *USDTFutures) DoNoAuthRequest(method, reqUrl string, params *url.Values, headers map[string]string) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(f.GetName(), metrics.Layer_NoAuth, method, reqUrl, start, err)
+		LogRequest(f.ExOpts.Logger, f.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(f.ExOpts.Context, f.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	var reqBody string
+
+	if method == http.MethodGet {
+		reqUrl += "?" + params.Encode()
+	} else {
+		reqBody = params.Encode()
+	}
+
+	httpSpan := tracing.StartStep(ctx, "http")
+	respBody, err := f.UriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		return DoRequestContext(ctx, Cli, method, reqUrl, reqBody, headers)
+	})
+	tracing.End(httpSpan, err)
+	if err != nil {
+		return respBody, fmt.Errorf("%w%s", err, errors.New(string(respBody)))
+	}
+
+	return respBody, nil
+}
@@ -0,0 +1,98 @@
+package futures
+
+import (
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// GetOpenInterestHistory 交易所只提供最近30天的数据,period最小为5min
+func (f *USDTFutures) GetOpenInterestHistory(pair CurrencyPair, period KlinePeriod, limit int, opts ...OptionParameter) ([]OpenInterest, []byte, error) {
+	data, err := f.doStatRequest(f.UriOpts.GetOpenInterestHistoryUri, pair, period, limit, opts...)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ois, err := f.UnmarshalerOpts.GetOpenInterestHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	//统一为按时间升序,超过limit时保留最近的limit条
+	sort.Slice(ois, func(i, j int) bool {
+		return ois[i].Timestamp < ois[j].Timestamp
+	})
+	if limit > 0 && len(ois) > limit {
+		ois = ois[len(ois)-limit:]
+	}
+
+	for i := range ois {
+		ois[i].Pair = pair
+	}
+
+	return ois, data, nil
+}
+
+// GetLongShortRatio 全部账户的多空人数比,大户的数据可以通过WithGetLongShortRatioUri修改为topLongShortAccountRatio
+func (f *USDTFutures) GetLongShortRatio(pair CurrencyPair, period KlinePeriod, limit int, opts ...OptionParameter) ([]LongShortRatio, []byte, error) {
+	data, err := f.doStatRequest(f.UriOpts.GetLongShortRatioUri, pair, period, limit, opts...)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ratios, err := f.UnmarshalerOpts.GetLongShortRatioResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	sort.Slice(ratios, func(i, j int) bool {
+		return ratios[i].Timestamp < ratios[j].Timestamp
+	})
+	if limit > 0 && len(ratios) > limit {
+		ratios = ratios[len(ratios)-limit:]
+	}
+
+	for i := range ratios {
+		ratios[i].Pair = pair
+	}
+
+	return ratios, data, nil
+}
+
+func (f *USDTFutures) GetTakerVolume(pair CurrencyPair, period KlinePeriod, limit int, opts ...OptionParameter) ([]TakerVolume, []byte, error) {
+	data, err := f.doStatRequest(f.UriOpts.GetTakerVolumeUri, pair, period, limit, opts...)
+	if err != nil {
+		return nil, data, err
+	}
+
+	vols, err := f.UnmarshalerOpts.GetTakerVolumeResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	sort.Slice(vols, func(i, j int) bool {
+		return vols[i].Timestamp < vols[j].Timestamp
+	})
+	if limit > 0 && len(vols) > limit {
+		vols = vols[len(vols)-limit:]
+	}
+
+	for i := range vols {
+		vols[i].Pair = pair
+	}
+
+	return vols, data, nil
+}
+
+func (f *USDTFutures) doStatRequest(uri string, pair CurrencyPair, period KlinePeriod, limit int, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("period", adaptKlinePeriod(period))
+	params.Set("limit", fmt.Sprint(limit))
+	MergeOptionParams(&params, opts...)
+
+	return f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.UriOpts.Endpoint, uri), &params, nil)
+}
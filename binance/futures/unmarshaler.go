@@ -0,0 +1,345 @@
+package futures
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/buger/jsonparser"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+	"math"
+)
+
+type RespUnmarshaler struct {
+}
+
+// UnmarshalGetPositionModeResponse dualSidePosition为true时是双向持仓
+func (u *RespUnmarshaler) UnmarshalGetPositionModeResponse(data []byte) (PositionMode, error) {
+	dual, err := jsonparser.GetBoolean(data, "dualSidePosition")
+	if err != nil {
+		return "", err
+	}
+	if dual {
+		return PositionMode_Hedge, nil
+	}
+	return PositionMode_OneWay, nil
+}
+
+func (u *RespUnmarshaler) UnmarshalCreateOrdersResponse(data []byte) ([]BatchOrderResult, error) {
+	var results []BatchOrderResult
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var result BatchOrderResult
+
+		//失败的订单返回 {"code":-2022,"msg":"ReduceOnly Order is rejected."}
+		if code, er := jsonparser.GetInt(value, "code"); er == nil && code != 0 {
+			msg, _ := jsonparser.GetString(value, "msg")
+			result.Err = fmt.Errorf("code=%d, msg=%s", code, msg)
+			results = append(results, result)
+			return
+		}
+
+		result.Order, result.Err = u.unmarshalOrderResponse(value)
+		results = append(results, result)
+	})
+
+	return results, err
+}
+
+func (u *RespUnmarshaler) UnmarshalCancelOrdersResponse(data []byte) ([]BatchOrderResult, error) {
+	return u.UnmarshalCreateOrdersResponse(data)
+}
+
+func (u *RespUnmarshaler) UnmarshalGetOrderInfoResponse(data []byte) (*Order, error) {
+	ord, err := u.unmarshalOrderResponse(data)
+	if err != nil {
+		return nil, err
+	}
+	return &ord, nil
+}
+
+func (u *RespUnmarshaler) UnmarshalGetFillsResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			trade              Trade
+			side, positionSide string
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				trade.Tid = valStr
+			case "orderId":
+				trade.OrderId = valStr
+			case "side":
+				side = valStr
+			case "positionSide":
+				positionSide = valStr
+			case "price":
+				trade.Price = cast.ToFloat64(valStr)
+			case "qty":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "commission":
+				trade.Fee = cast.ToFloat64(valStr)
+			case "commissionAsset":
+				trade.FeeCcy = valStr
+			case "maker":
+				trade.IsMaker = valStr == "true"
+			case "time":
+				trade.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		trade.Side = adaptOrderOrigSide(side, positionSide, false) //成交明细没有reduceOnly,单向持仓时按开仓处理
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
+
+func (u *RespUnmarshaler) unmarshalOrderResponse(data []byte) (ord Order, err error) {
+	var (
+		side, positionSide string
+		reduceOnly         bool
+	)
+
+	err = jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "orderId":
+			ord.Id = valStr
+		case "clientOrderId":
+			ord.CId = valStr
+		case "price":
+			ord.Price = cast.ToFloat64(valStr)
+		case "origQty":
+			ord.Qty = cast.ToFloat64(valStr)
+		case "executedQty":
+			ord.ExecutedQty = cast.ToFloat64(valStr)
+		case "avgPrice":
+			ord.PriceAvg = cast.ToFloat64(valStr)
+		case "time":
+			ord.CreatedAt = cast.ToInt64(valStr)
+		case "status":
+			ord.Status = adaptOrderStatus(valStr)
+		case "side":
+			side = valStr
+		case "positionSide":
+			positionSide = valStr
+		case "reduceOnly":
+			reduceOnly = valStr == "true"
+		case "type":
+			ord.OrderTy = adaptOrderOrigType(valStr)
+		}
+		return nil
+	})
+
+	ord.Side = adaptOrderOrigSide(side, positionSide, reduceOnly)
+
+	return
+}
+
+func (u *RespUnmarshaler) UnmarshalGetServerTimeResponse(data []byte) (int64, error) {
+	return jsonparser.GetInt(data, "serverTime")
+}
+
+func (u *RespUnmarshaler) UnmarshalResponse(data []byte, res interface{}) error {
+	return json.Unmarshal(data, res)
+}
+
+// UnmarshalGetFundingRateResponse 解析premiumIndex接口
+func (u *RespUnmarshaler) UnmarshalGetFundingRateResponse(data []byte) (*FundingRate, error) {
+	rate := new(FundingRate)
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "lastFundingRate":
+			rate.Rate = cast.ToFloat64(valStr)
+		case "nextFundingTime":
+			rate.FundingTime = cast.ToInt64(valStr)
+			rate.NextFundingTime = rate.FundingTime
+		case "time":
+			rate.Timestamp = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+	return rate, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetFundingRateHistoryResponse(data []byte) ([]FundingRate, error) {
+	var rates []FundingRate
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var rate FundingRate
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "fundingRate":
+				rate.Rate = cast.ToFloat64(valStr)
+			case "fundingTime":
+				rate.FundingTime = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		rates = append(rates, rate)
+	})
+
+	return rates, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetMarkPriceResponse(data []byte) (*MarkPrice, error) {
+	markPx, err := jsonparser.GetString(data, "markPrice")
+	if err != nil {
+		return nil, err
+	}
+
+	price := new(MarkPrice)
+	price.Price = cast.ToFloat64(markPx)
+	price.Timestamp, _ = jsonparser.GetInt(data, "time")
+
+	return price, nil
+}
+
+func (u *RespUnmarshaler) UnmarshalGetIndexPriceResponse(data []byte) (*IndexPrice, error) {
+	indexPx, err := jsonparser.GetString(data, "indexPrice")
+	if err != nil {
+		return nil, err
+	}
+
+	price := new(IndexPrice)
+	price.Price = cast.ToFloat64(indexPx)
+	price.Timestamp, _ = jsonparser.GetInt(data, "time")
+
+	return price, nil
+}
+
+func (u *RespUnmarshaler) UnmarshalGetOpenInterestHistoryResponse(data []byte) ([]OpenInterest, error) {
+	var ois []OpenInterest
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var oi OpenInterest
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "sumOpenInterest":
+				oi.Qty = cast.ToFloat64(valStr)
+			case "sumOpenInterestValue":
+				oi.Value = cast.ToFloat64(valStr)
+			case "timestamp":
+				oi.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		ois = append([]OpenInterest{oi}, ois...) //接口按时间正序返回
+	})
+
+	return ois, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetLongShortRatioResponse(data []byte) ([]LongShortRatio, error) {
+	var ratios []LongShortRatio
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var ratio LongShortRatio
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "longShortRatio":
+				ratio.Ratio = cast.ToFloat64(valStr)
+			case "longAccount":
+				ratio.LongRatio = cast.ToFloat64(valStr)
+			case "shortAccount":
+				ratio.ShortRatio = cast.ToFloat64(valStr)
+			case "timestamp":
+				ratio.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		ratios = append([]LongShortRatio{ratio}, ratios...)
+	})
+
+	return ratios, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetTakerVolumeResponse(data []byte) ([]TakerVolume, error) {
+	var vols []TakerVolume
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var vol TakerVolume
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "buyVol":
+				vol.BuyVol = cast.ToFloat64(valStr)
+			case "sellVol":
+				vol.SellVol = cast.ToFloat64(valStr)
+			case "timestamp":
+				vol.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		vols = append([]TakerVolume{vol}, vols...)
+	})
+
+	return vols, err
+}
+
+// UnmarshalGetLeverageResponse 解析positionRisk接口,双向持仓时多空仓位的杠杆倍数相同
+func (u *RespUnmarshaler) UnmarshalGetLeverageResponse(data []byte) (float64, error) {
+	lever, err := jsonparser.GetString(data, "[0]", "leverage")
+	if err != nil {
+		return 0, err
+	}
+	return cast.ToFloat64(lever), nil
+}
+
+func (u *RespUnmarshaler) UnmarshalGetBillsResponse(data []byte) ([]Bill, error) {
+	var bills []Bill
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var bill Bill
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "tranId":
+				bill.Id = valStr
+			case "asset":
+				bill.Coin = valStr
+			case "symbol":
+				bill.Symbol = valStr
+			case "incomeType":
+				bill.RawType = valStr
+				bill.Type = adaptIncomeType(valStr)
+			case "income":
+				bill.Amount = cast.ToFloat64(valStr)
+			case "time":
+				bill.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		if bill.Type == BillType_Fee {
+			bill.Fee = math.Abs(bill.Amount)
+		}
+		bills = append([]Bill{bill}, bills...) //接口按时间正序返回
+	})
+
+	return bills, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetTradeFeeResponse(data []byte) (*TradeFee, error) {
+	var fee TradeFee
+
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "makerCommissionRate":
+			fee.Maker = cast.ToFloat64(valStr)
+		case "takerCommissionRate":
+			fee.Taker = cast.ToFloat64(valStr)
+		}
+		return nil
+	})
+
+	return &fee, err
+}
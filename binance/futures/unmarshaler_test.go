@@ -0,0 +1,67 @@
+package futures
+
+import (
+	. "github.com/nntaoli-project/goex/v2/model"
+	"testing"
+)
+
+func TestRespUnmarshaler_UnmarshalGetPositionModeResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    PositionMode
+		wantErr bool
+	}{
+		{name: "hedge", data: `{"dualSidePosition":true}`, want: PositionMode_Hedge},
+		{name: "one-way", data: `{"dualSidePosition":false}`, want: PositionMode_OneWay},
+		{name: "missing", data: `{}`, wantErr: true},
+		{name: "not bool", data: `{"dualSidePosition":"true"}`, wantErr: true},
+	}
+
+	u := new(RespUnmarshaler)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, err := u.UnmarshalGetPositionModeResponse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mode != tt.want {
+				t.Fatalf("mode = %s, want %s", mode, tt.want)
+			}
+		})
+	}
+}
+
+func TestRespUnmarshaler_UnmarshalGetTradeFeeResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantMaker float64
+		wantTaker float64
+		wantErr   bool
+	}{
+		{
+			name:      "commission rate",
+			data:      `{"symbol":"BTCUSDT","makerCommissionRate":"0.0002","takerCommissionRate":"0.0004"}`,
+			wantMaker: 0.0002,
+			wantTaker: 0.0004,
+		},
+		{name: "not object", data: `[]`, wantErr: true},
+	}
+
+	u := new(RespUnmarshaler)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fee, err := u.UnmarshalGetTradeFeeResponse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if fee.Maker != tt.wantMaker || fee.Taker != tt.wantTaker {
+				t.Fatalf("fee = (%v, %v), want (%v, %v)", fee.Maker, fee.Taker, tt.wantMaker, tt.wantTaker)
+			}
+		})
+	}
+}
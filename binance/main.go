@@ -1,13 +1,19 @@
 package binance
 
-import "github.com/nntaoli-project/goex/v2/binance/spot"
+import (
+	"github.com/nntaoli-project/goex/v2/binance/futures"
+	"github.com/nntaoli-project/goex/v2/binance/spot"
+	"github.com/nntaoli-project/goex/v2/options"
+)
 
 type Binance struct {
-	Spot *spot.Spot
+	Spot    *spot.Spot
+	Futures *futures.Futures
 }
 
-func New() *Binance {
+func New(opts ...options.ExchangeOption) *Binance {
 	return &Binance{
-		Spot: spot.New(),
+		Spot:    spot.New(opts...),
+		Futures: futures.New(opts...),
 	}
 }
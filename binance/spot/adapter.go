@@ -100,3 +100,48 @@ func adaptOrderOrigStatus(st string) model.OrderStatus {
 		return model.OrderStatus(-1)
 	}
 }
+
+// adaptIsBuyerMakerToSide 买方是maker时主动成交方向为卖
+func adaptIsBuyerMakerToSide(isBuyerMaker string) model.OrderSide {
+	if isBuyerMaker == "true" {
+		return model.Spot_Sell
+	}
+	return model.Spot_Buy
+}
+
+func adaptAccountType(ty model.AccountType) string {
+	switch ty {
+	case model.AccountType_Funding:
+		return "FUNDING"
+	case model.AccountType_Spot:
+		return "MAIN"
+	case model.AccountType_Margin:
+		return "MARGIN"
+	case model.AccountType_USDTFutures:
+		return "UMFUTURE"
+	case model.AccountType_CoinFutures:
+		return "CMFUTURE"
+	}
+	return string(ty)
+}
+
+func adaptSymToAccountType(sym string) model.AccountType {
+	switch sym {
+	case "FUNDING":
+		return model.AccountType_Funding
+	case "MAIN":
+		return model.AccountType_Spot
+	case "MARGIN":
+		return model.AccountType_Margin
+	case "UMFUTURE":
+		return model.AccountType_USDTFutures
+	case "CMFUTURE":
+		return model.AccountType_CoinFutures
+	}
+	return model.AccountType(sym)
+}
+
+// adaptTransferType 万向划转类型,例如 MAIN_UMFUTURE 现货账户转入U本位合约账户
+func adaptTransferType(from, to model.AccountType) string {
+	return adaptAccountType(from) + "_" + adaptAccountType(to)
+}
@@ -0,0 +1,56 @@
+package spot
+
+import (
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// Transfer 万向划转,需要api key开启万向划转权限
+func (s *PrvApi) Transfer(coin string, amount float64, from, to AccountType, opt ...OptionParameter) (string, []byte, error) {
+	params := url.Values{}
+	params.Set("type", adaptTransferType(from, to))
+	params.Set("asset", coin)
+	params.Set("amount", FloatToString(amount, 8))
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodPost, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.TransferUri), &params, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	id, err := s.UnmarshalerOpts.TransferResponseUnmarshaler(data)
+	return id, data, err
+}
+
+// GetTransferHistory 查询万向划转记录,交易所不支持按币种过滤,coin不为空时在本地过滤
+func (s *PrvApi) GetTransferHistory(coin string, from, to AccountType, opt ...OptionParameter) ([]TransferRecord, []byte, error) {
+	params := url.Values{}
+	params.Set("type", adaptTransferType(from, to))
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetTransferHistoryUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	records, err := s.UnmarshalerOpts.GetTransferHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if coin == "" {
+		return records, data, nil
+	}
+
+	filtered := make([]TransferRecord, 0, len(records))
+	for _, r := range records {
+		if r.Coin == coin {
+			filtered = append(filtered, r)
+		}
+	}
+
+	return filtered, data, nil
+}
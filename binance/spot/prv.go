@@ -1,15 +1,19 @@
 package spot
 
 import (
+	"errors"
 	"fmt"
+	"github.com/buger/jsonparser"
 	"github.com/nntaoli-project/goex/v2/binance/common"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
-	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	. "github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/tracing"
 	. "github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 type PrvApi struct {
@@ -65,7 +69,88 @@ func (s *PrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side OrderSi
 }
 
 func (s *PrvApi) GetOrderInfo(pair CurrencyPair, id string, opt ...OptionParameter) (*Order, []byte, error) {
-	panic("")
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("orderId", id)
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetOrderUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := s.UnmarshalerOpts.GetOrderInfoResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord.Pair = pair
+
+	return ord, data, nil
+}
+
+// AmendOrder 通过cancelReplace撤销原订单并下新单,返回的订单为新订单(订单ID会改变)
+//
+//	opt 同时传入side和type时不查询原订单,这时newQty和newPrice必须大于0,
+//	否则查询原订单补全side、type、timeInForce以及未修改的数量和价格;
+//	timeInForce 可以通过opt传入,没有时使用原订单的,限价单默认GTC
+func (s *PrvApi) AmendOrder(pair CurrencyPair, id string, newQty, newPrice float64, opt ...OptionParameter) (*Order, []byte, error) {
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("cancelReplaceMode", "STOP_ON_FAILURE")
+	params.Set("cancelOrderId", id)
+	MergeOptionParams(&params, opt...)
+
+	if params.Get("side") == "" || params.Get("type") == "" {
+		origOrd, data, err := s.GetOrderInfo(pair, id)
+		if err != nil {
+			return nil, data, err
+		}
+
+		if newQty <= 0 {
+			newQty = origOrd.Qty
+		}
+		if newPrice <= 0 {
+			newPrice = origOrd.Price
+		}
+		if params.Get("side") == "" {
+			params.Set("side", adaptOrderSide(origOrd.Side))
+		}
+		if params.Get("type") == "" {
+			params.Set("type", adaptOrderType(origOrd.OrderTy))
+		}
+		if tif, _ := jsonparser.GetString(data, "timeInForce"); tif != "" && params.Get("timeInForce") == "" {
+			params.Set("timeInForce", tif)
+		}
+	} else if newQty <= 0 || newPrice <= 0 {
+		return nil, nil, errors.New("newQty and newPrice are required when side and type are passed by opt")
+	}
+
+	if params.Get("timeInForce") == "" && params.Get("type") == "LIMIT" {
+		params.Set("timeInForce", "GTC")
+	}
+	params.Set("quantity", FloatToString(newQty, pair.QtyPrecision))
+	params.Set("price", FloatToString(newPrice, pair.PricePrecision))
+
+	data, err := s.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.AmendOrderUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := s.UnmarshalerOpts.AmendOrderResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord.Pair = pair
+	ord.Qty = newQty
+	ord.Price = newPrice
+	ord.Side = adaptOrderOrigSide(params.Get("side"))
+	ord.OrderTy = adaptOrderOrigType(params.Get("type"))
+
+	return ord, data, nil
 }
 
 func (s *PrvApi) GetPendingOrders(pair CurrencyPair, opt ...OptionParameter) ([]Order, []byte, error) {
@@ -101,16 +186,93 @@ func (s *PrvApi) CancelOrder(pair CurrencyPair, id string, opt ...OptionParamete
 	return data, s.UnmarshalerOpts.CancelOrderResponseUnmarshaler(data)
 }
 
-func (s *PrvApi) DoAuthRequest(method, reqUrl string, params *url.Values, header map[string]string) ([]byte, error) {
+func (s *PrvApi) GetFills(pair CurrencyPair, opt ...OptionParameter) ([]Trade, []byte, error) {
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetFillsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	trades, err := s.UnmarshalerOpts.GetFillsResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
+func (s *PrvApi) GetTradeFee(pair CurrencyPair, opt ...OptionParameter) (*TradeFee, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetTradeFeeUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	fee, err := s.UnmarshalerOpts.GetTradeFeeResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+	fee.Pair = pair
+
+	return fee, data, nil
+}
+
+func (s *PrvApi) CancelAllOrders(pair CurrencyPair, opt ...OptionParameter) ([]byte, error) {
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opt...)
+	return s.DoAuthRequest(http.MethodDelete, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.CancelAllOrdersUri), &params, nil)
+}
+
+func (s *PrvApi) DoAuthRequest(method, reqUrl string, params *url.Values, header map[string]string) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(s.GetName(), metrics.Layer_Auth, method, reqUrl, start, err)
+		LogRequest(s.ExOpts.Logger, s.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(s.ExOpts.Context, s.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	if header == nil {
 		header = make(map[string]string, 2)
 	}
-	header["X-MBX-APIKEY"] = s.apiOpts.Key
-	common.SignParams(params, s.apiOpts.Secret)
+
+	signSpan := tracing.StartStep(ctx, "sign")
+	cred, err := s.apiOpts.Credential()
+	if err != nil {
+		tracing.End(signSpan, err)
+		return nil, err
+	}
+	defer cred.Zero()
+
+	header["X-MBX-APIKEY"] = string(cred.Key)
+	err = common.SignParams(params, cred.Secret, s.apiOpts)
+	tracing.End(signSpan, err)
+	if err != nil {
+		return nil, err
+	}
 	//if http.MethodGet == method {
 	reqUrl += "?" + params.Encode()
 	//}
-	respBody, err := Cli.DoRequest(method, reqUrl, "", header)
-	logger.Debugf("[DoAuthRequest] response body: %s", string(respBody))
+	httpSpan := tracing.StartStep(ctx, "http")
+	respBody, err := s.UriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		return DoRequestContext(ctx, Cli, method, reqUrl, "", header)
+	})
+	tracing.End(httpSpan, err)
+	s.ExOpts.Logger.Debug("response", "exchange", s.GetName(), "url", reqUrl, "body", LogBody(respBody))
 	return respBody, err
 }
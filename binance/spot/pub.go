@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
-	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/tracing"
 	. "github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 func (s *Spot) GetName() string {
@@ -26,7 +29,7 @@ func (s *Spot) GetDepth(pair CurrencyPair, size int, opts ...OptionParameter) (*
 	if err != nil {
 		return nil, data, err
 	}
-	logger.Debugf("[GetDepth] %s", string(data))
+	s.ExOpts.Logger.Debug("get depth", "body", LogBody(data))
 	dep, err := s.UnmarshalerOpts.DepthUnmarshaler(data)
 	return dep, data, err
 }
@@ -78,11 +81,67 @@ func (s *Spot) GetKline(pair CurrencyPair, period KlinePeriod, opts ...OptionPar
 	return klines, respBody, err
 }
 
+func (s *Spot) GetTrades(pair CurrencyPair, limit int, opts ...OptionParameter) ([]Trade, []byte, error) {
+	return s.getTrades(s.UriOpts.GetTradesUri, s.UnmarshalerOpts.GetTradesResponseUnmarshaler, pair, limit, opts...)
+}
+
+// GetAggTrades 获取归集成交,同一taker订单在同一价格的成交会合并为一条
+func (s *Spot) GetAggTrades(pair CurrencyPair, limit int, opts ...OptionParameter) ([]Trade, []byte, error) {
+	return s.getTrades(s.UriOpts.GetAggTradesUri, s.UnmarshalerOpts.GetAggTradesResponseUnmarshaler, pair, limit, opts...)
+}
+
+func (s *Spot) getTrades(uri string, unmarshaler GetTradesResponseUnmarshaler, pair CurrencyPair, limit int, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("limit", fmt.Sprint(limit))
+	MergeOptionParams(&params, opts...)
+
+	respBody, err := s.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, uri), &params, nil)
+	if err != nil {
+		return nil, respBody, err
+	}
+
+	trades, err := unmarshaler(respBody)
+	if err != nil {
+		return nil, respBody, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, respBody, nil
+}
+
 func (s *Spot) GetExchangeInfo() (map[string]CurrencyPair, []byte, error) {
 	panic("not implement")
 }
 
-func (s *Spot) DoNoAuthRequest(method, reqUrl string, params *url.Values, headers map[string]string) ([]byte, error) {
+// GetServerTime 服务器时间(毫秒)
+func (s *Spot) GetServerTime(opts ...OptionParameter) (int64, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	respBody, err := s.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetServerTimeUri), &params, nil)
+	if err != nil {
+		return 0, respBody, err
+	}
+
+	ts, err := s.UnmarshalerOpts.GetServerTimeResponseUnmarshaler(respBody)
+	return ts, respBody, err
+}
+
+func (s *Spot) DoNoAuthRequest(method, reqUrl string, params *url.Values, headers map[string]string) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(s.GetName(), metrics.Layer_NoAuth, method, reqUrl, start, err)
+		LogRequest(s.ExOpts.Logger, s.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(s.ExOpts.Context, s.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	var reqBody string
 
 	if method == http.MethodGet {
@@ -91,7 +150,11 @@ func (s *Spot) DoNoAuthRequest(method, reqUrl string, params *url.Values, header
 		reqBody = params.Encode()
 	}
 
-	responseData, err := Cli.DoRequest(method, reqUrl, reqBody, headers)
+	httpSpan := tracing.StartStep(ctx, "http")
+	responseData, err := s.UriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		return DoRequestContext(ctx, Cli, method, reqUrl, reqBody, headers)
+	})
+	tracing.End(httpSpan, err)
 	if err != nil {
 		return responseData, err
 	}
@@ -1,6 +1,7 @@
 package spot
 
 import (
+	"github.com/nntaoli-project/goex/v2/httpcli"
 	. "github.com/nntaoli-project/goex/v2/model"
 	. "github.com/nntaoli-project/goex/v2/options"
 )
@@ -12,32 +13,77 @@ var (
 type Spot struct {
 	UnmarshalerOpts UnmarshalerOptions
 	UriOpts         UriOptions
+	ExOpts          ExchangeOptions
 }
 
-func New() *Spot {
+// New 测试网(Env_Demo/Env_Testnet)不支持sapi接口
+func New(opts ...ExchangeOption) *Spot {
 	unmarshaler := new(RespUnmarshaler)
 	s := &Spot{
+		ExOpts: NewExchangeOptions(opts...),
 		UriOpts: UriOptions{
-			Endpoint:            "https://api.binance.com",
-			TickerUri:           "/api/v3/ticker/24hr",
-			DepthUri:            "/api/v3/depth",
-			KlineUri:            "/api/v3/klines",
-			NewOrderUri:         "/api/v3/order",
-			GetPendingOrdersUri: "/api/v3/openOrders",
-			CancelOrderUri:      "/api/v3/order",
-			GetOrderUri:         "/api/v3/order",
-			GetHistoryOrdersUri: "/api/v3/allOrders",
+			Endpoint:                "https://api.binance.com",
+			TickerUri:               "/api/v3/ticker/24hr",
+			GetServerTimeUri:        "/api/v3/time",
+			DepthUri:                "/api/v3/depth",
+			KlineUri:                "/api/v3/klines",
+			GetTradesUri:            "/api/v3/trades",
+			GetAggTradesUri:         "/api/v3/aggTrades",
+			NewOrderUri:             "/api/v3/order",
+			GetPendingOrdersUri:     "/api/v3/openOrders",
+			CancelOrderUri:          "/api/v3/order",
+			GetOrderUri:             "/api/v3/order",
+			GetHistoryOrdersUri:     "/api/v3/allOrders",
+			AmendOrderUri:           "/api/v3/order/cancelReplace",
+			CancelAllOrdersUri:      "/api/v3/openOrders",
+			GetFillsUri:             "/api/v3/myTrades",
+			TransferUri:             "/sapi/v1/asset/transfer",
+			GetTransferHistoryUri:   "/sapi/v1/asset/transfer",
+			GetCoinNetworksUri:      "/sapi/v1/capital/config/getall",
+			GetDepositAddressUri:    "/sapi/v1/capital/deposit/address",
+			GetDepositHistoryUri:    "/sapi/v1/capital/deposit/hisrec",
+			GetWithdrawHistoryUri:   "/sapi/v1/capital/withdraw/history",
+			WithdrawUri:             "/sapi/v1/capital/withdraw/apply",
+			GetSubAccountsUri:       "/sapi/v1/sub-account/list",
+			GetSubAccountBalanceUri: "/sapi/v3/sub-account/assets",
+			SubAccountTransferUri:   "/sapi/v1/sub-account/universalTransfer",
+			GetTradeFeeUri:          "/sapi/v1/asset/tradeFee",
 		},
 		UnmarshalerOpts: UnmarshalerOptions{
-			ResponseUnmarshaler:                 unmarshaler.UnmarshalResponse,
-			TickerUnmarshaler:                   unmarshaler.UnmarshalGetTickerResponse,
-			DepthUnmarshaler:                    unmarshaler.UnmarshalGetDepthResponse,
-			KlineUnmarshaler:                    unmarshaler.UnmarshalGetKlineResponse,
-			CreateOrderResponseUnmarshaler:      unmarshaler.UnmarshalCreateOrderResponse,
-			GetPendingOrdersResponseUnmarshaler: unmarshaler.UnmarshalGetPendingOrdersResponse,
-			CancelOrderResponseUnmarshaler:      unmarshaler.UnmarshalCancelOrderResponse,
+			ResponseUnmarshaler:                   unmarshaler.UnmarshalResponse,
+			GetServerTimeResponseUnmarshaler:      unmarshaler.UnmarshalGetServerTimeResponse,
+			TickerUnmarshaler:                     unmarshaler.UnmarshalGetTickerResponse,
+			DepthUnmarshaler:                      unmarshaler.UnmarshalGetDepthResponse,
+			KlineUnmarshaler:                      unmarshaler.UnmarshalGetKlineResponse,
+			GetTradesResponseUnmarshaler:          unmarshaler.UnmarshalGetTradesResponse,
+			GetAggTradesResponseUnmarshaler:       unmarshaler.UnmarshalGetAggTradesResponse,
+			CreateOrderResponseUnmarshaler:        unmarshaler.UnmarshalCreateOrderResponse,
+			GetPendingOrdersResponseUnmarshaler:   unmarshaler.UnmarshalGetPendingOrdersResponse,
+			CancelOrderResponseUnmarshaler:        unmarshaler.UnmarshalCancelOrderResponse,
+			GetOrderInfoResponseUnmarshaler:       unmarshaler.UnmarshalGetOrderInfoResponse,
+			AmendOrderResponseUnmarshaler:         unmarshaler.UnmarshalAmendOrderResponse,
+			GetFillsResponseUnmarshaler:           unmarshaler.UnmarshalGetFillsResponse,
+			GetTradeFeeResponseUnmarshaler:        unmarshaler.UnmarshalGetTradeFeeResponse,
+			TransferResponseUnmarshaler:           unmarshaler.UnmarshalTransferResponse,
+			GetTransferHistoryResponseUnmarshaler: unmarshaler.UnmarshalGetTransferHistoryResponse,
+			GetCoinNetworksResponseUnmarshaler:    unmarshaler.UnmarshalGetCoinNetworksResponse,
+			GetDepositAddressResponseUnmarshaler:  unmarshaler.UnmarshalGetDepositAddressResponse,
+			GetDepositHistoryResponseUnmarshaler:  unmarshaler.UnmarshalGetDepositHistoryResponse,
+			GetWithdrawHistoryResponseUnmarshaler: unmarshaler.UnmarshalGetWithdrawHistoryResponse,
+			WithdrawResponseUnmarshaler:           unmarshaler.UnmarshalWithdrawResponse,
+			GetAccountResponseUnmarshaler:         unmarshaler.UnmarshalGetAccountResponse,
+			GetSubAccountsResponseUnmarshaler:     unmarshaler.UnmarshalGetSubAccountsResponse,
 		},
 	}
+
+	if s.ExOpts.IsSandbox() {
+		s.UriOpts.Endpoint = "https://testnet.binance.vision"
+	} else {
+		s.UriOpts.EndpointPool = httpcli.NewEndpointPool(s.ExOpts.Logger, s.UriOpts.GetServerTimeUri, s.UriOpts.Endpoint,
+			"https://api1.binance.com", "https://api2.binance.com", "https://api3.binance.com", "https://api4.binance.com")
+		s.UriOpts.EndpointPool.Start(s.ExOpts.EndpointProbeInterval)
+	}
+
 	return s
 }
 
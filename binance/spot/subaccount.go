@@ -0,0 +1,72 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+func (s *PrvApi) GetSubAccounts(opt ...OptionParameter) ([]SubAccount, []byte, error) {
+	params := url.Values{}
+	params.Set("limit", "200")
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetSubAccountsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	subAccounts, err := s.UnmarshalerOpts.GetSubAccountsResponseUnmarshaler(data)
+	return subAccounts, data, err
+}
+
+// GetSubAccountBalance 获取子账户现货资产,subAccount为子账户邮箱
+func (s *PrvApi) GetSubAccountBalance(subAccount string, opt ...OptionParameter) (map[string]Account, []byte, error) {
+	params := url.Values{}
+	params.Set("email", subAccount)
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetSubAccountBalanceUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	balances, err := s.UnmarshalerOpts.GetAccountResponseUnmarshaler(data)
+	return balances, data, err
+}
+
+// SubAccountTransfer 母子账户现货账户之间的万向划转,可以通过opts的fromAccountType/toAccountType指定账户类型
+func (s *PrvApi) SubAccountTransfer(coin string, amount float64, fromSub, toSub string, opt ...OptionParameter) (string, []byte, error) {
+	if fromSub == "" && toSub == "" {
+		return "", nil, errors.New("fromSub and toSub can not both be master account")
+	}
+
+	params := url.Values{}
+	if fromSub != "" {
+		params.Set("fromEmail", fromSub)
+	}
+	if toSub != "" {
+		params.Set("toEmail", toSub)
+	}
+	params.Set("fromAccountType", "SPOT")
+	params.Set("toAccountType", "SPOT")
+	params.Set("asset", coin)
+	params.Set("amount", FloatToString(amount, 8))
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodPost, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.SubAccountTransferUri), &params, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	id, err := s.UnmarshalerOpts.TransferResponseUnmarshaler(data)
+	return id, data, err
+}
+
+// CreateSubAccountApiKey binance普通母账户没有创建子账户API Key的接口(只对经纪商开放)
+func (s *PrvApi) CreateSubAccountApiKey(subAccount, label string, canTrade bool, ips []string, opt ...OptionParameter) (*SubAccountApiKey, []byte, error) {
+	return nil, nil, errors.New("binance not support create sub account api key")
+}
@@ -2,10 +2,13 @@ package spot
 
 import (
 	"encoding/json"
+	"errors"
 	"github.com/buger/jsonparser"
 	"github.com/nntaoli-project/goex/v2/logger"
 	. "github.com/nntaoli-project/goex/v2/model"
 	"github.com/spf13/cast"
+	"strings"
+	"time"
 )
 
 type RespUnmarshaler struct {
@@ -120,6 +123,60 @@ func (u *RespUnmarshaler) UnmarshalGetKlineResponse(data []byte) ([]Kline, error
 	return klines, err
 }
 
+func (u *RespUnmarshaler) UnmarshalGetTradesResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var trade Trade
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				trade.Tid = valStr
+			case "price":
+				trade.Price = cast.ToFloat64(valStr)
+			case "qty":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "time":
+				trade.Timestamp = cast.ToInt64(valStr)
+			case "isBuyerMaker":
+				trade.Side = adaptIsBuyerMakerToSide(valStr)
+			}
+			return nil
+		})
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetAggTradesResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var trade Trade
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "a":
+				trade.Tid = valStr
+			case "p":
+				trade.Price = cast.ToFloat64(valStr)
+			case "q":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "T":
+				trade.Timestamp = cast.ToInt64(valStr)
+			case "m":
+				trade.Side = adaptIsBuyerMakerToSide(valStr)
+			}
+			return nil
+		})
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
+
 func (u *RespUnmarshaler) UnmarshalCreateOrderResponse(data []byte) (*Order, error) {
 	var ord = new(Order)
 	err := jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
@@ -159,6 +216,29 @@ func (u *RespUnmarshaler) UnmarshalGetPendingOrdersResponse(data []byte) ([]Orde
 	return orders, err
 }
 
+func (u *RespUnmarshaler) UnmarshalGetOrderInfoResponse(data []byte) (*Order, error) {
+	ord, err := u.unmarshalOrderResponse(data)
+	if err != nil {
+		return nil, err
+	}
+	return &ord, nil
+}
+
+// UnmarshalAmendOrderResponse cancelReplace接口返回撤单及新订单结果,这里只解析新订单
+func (u *RespUnmarshaler) UnmarshalAmendOrderResponse(data []byte) (*Order, error) {
+	newOrderResult, _ := jsonparser.GetString(data, "newOrderResult")
+	if newOrderResult != "SUCCESS" {
+		return nil, errors.New(string(data))
+	}
+
+	newOrderData, _, _, err := jsonparser.Get(data, "newOrderResponse")
+	if err != nil {
+		return nil, err
+	}
+
+	return u.UnmarshalCreateOrderResponse(newOrderData)
+}
+
 func (u *RespUnmarshaler) unmarshalOrderResponse(data []byte) (ord Order, err error) {
 	err = jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
 		valStr := string(val)
@@ -187,10 +267,272 @@ func (u *RespUnmarshaler) unmarshalOrderResponse(data []byte) (ord Order, err er
 	return
 }
 
+func (u *RespUnmarshaler) UnmarshalGetFillsResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var trade Trade
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				trade.Tid = valStr
+			case "orderId":
+				trade.OrderId = valStr
+			case "price":
+				trade.Price = cast.ToFloat64(valStr)
+			case "qty":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "commission":
+				trade.Fee = cast.ToFloat64(valStr)
+			case "commissionAsset":
+				trade.FeeCcy = valStr
+			case "time":
+				trade.Timestamp = cast.ToInt64(valStr)
+			case "isBuyer":
+				if valStr == "true" {
+					trade.Side = Spot_Buy
+				} else {
+					trade.Side = Spot_Sell
+				}
+			case "isMaker":
+				trade.IsMaker = valStr == "true"
+			}
+			return nil
+		})
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
+
 func (u *RespUnmarshaler) UnmarshalCancelOrderResponse(data []byte) error {
 	return nil
 }
 
+func (u *RespUnmarshaler) UnmarshalGetServerTimeResponse(data []byte) (int64, error) {
+	return jsonparser.GetInt(data, "serverTime")
+}
+
 func (u *RespUnmarshaler) UnmarshalResponse(data []byte, res interface{}) error {
 	return json.Unmarshal(data, res)
 }
+
+// UnmarshalGetAccountResponse 解析balances数组,账户信息和子账户资产接口格式相同
+func (u *RespUnmarshaler) UnmarshalGetAccountResponse(data []byte) (map[string]Account, error) {
+	var accMap = make(map[string]Account, 4)
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var acc Account
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "asset":
+				acc.Coin = valStr
+			case "free":
+				acc.AvailableBalance = cast.ToFloat64(valStr)
+			case "locked":
+				acc.FrozenBalance = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		acc.Balance = acc.AvailableBalance + acc.FrozenBalance
+		accMap[acc.Coin] = acc
+	}, "balances")
+
+	return accMap, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetSubAccountsResponse(data []byte) ([]SubAccount, error) {
+	var subAccounts []SubAccount
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var sub SubAccount
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "email":
+				sub.Name = valStr
+			case "isFreeze":
+				sub.Enabled = !cast.ToBool(valStr)
+			case "createTime":
+				sub.CreatedAt = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		subAccounts = append(subAccounts, sub)
+	}, "subAccounts")
+
+	return subAccounts, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetTradeFeeResponse(data []byte) (*TradeFee, error) {
+	var fee TradeFee
+
+	err := jsonparser.ObjectEach(data[1:len(data)-1], func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "makerCommission":
+			fee.Maker = cast.ToFloat64(valStr)
+		case "takerCommission":
+			fee.Taker = cast.ToFloat64(valStr)
+		}
+		return nil
+	})
+
+	return &fee, err
+}
+
+func (u *RespUnmarshaler) UnmarshalTransferResponse(data []byte) (string, error) {
+	tranId, _, _, err := jsonparser.Get(data, "tranId")
+	if err != nil {
+		return "", errors.New(string(data))
+	}
+	return string(tranId), nil
+}
+
+func (u *RespUnmarshaler) UnmarshalGetTransferHistoryResponse(data []byte) ([]TransferRecord, error) {
+	var records []TransferRecord
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var record TransferRecord
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "tranId":
+				record.Id = valStr
+			case "asset":
+				record.Coin = valStr
+			case "amount":
+				record.Amount = cast.ToFloat64(valStr)
+			case "type":
+				if types := strings.SplitN(valStr, "_", 2); len(types) == 2 {
+					record.From = adaptSymToAccountType(types[0])
+					record.To = adaptSymToAccountType(types[1])
+				}
+			case "timestamp":
+				record.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		records = append(records, record)
+	}, "rows")
+
+	if errors.Is(err, jsonparser.KeyPathNotFoundError) { //没有记录时不返回rows
+		return records, nil
+	}
+
+	return records, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetCoinNetworksResponse(data []byte) ([]CoinNetwork, error) {
+	var networks []CoinNetwork
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		_, err = jsonparser.ArrayEach(value, func(item []byte, dataType jsonparser.ValueType, offset int, err error) {
+			var network CoinNetwork
+			_ = jsonparser.ObjectEach(item, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+				valStr := string(val)
+				switch string(key) {
+				case "coin":
+					network.Coin = valStr
+				case "network":
+					network.Network = valStr
+				case "depositEnable":
+					network.CanDeposit = valStr == "true"
+				case "withdrawEnable":
+					network.CanWithdraw = valStr == "true"
+				case "withdrawFee":
+					network.WithdrawFee = cast.ToFloat64(valStr)
+				case "withdrawMin":
+					network.WithdrawMin = cast.ToFloat64(valStr)
+				case "withdrawMax":
+					network.WithdrawMax = cast.ToFloat64(valStr)
+				}
+				return nil
+			})
+			networks = append(networks, network)
+		}, "networkList")
+	})
+
+	return networks, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetDepositAddressResponse(data []byte) ([]DepositAddress, error) {
+	var addr DepositAddress
+
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "coin":
+			addr.Coin = valStr
+		case "address":
+			addr.Address = valStr
+		case "tag":
+			addr.Tag = valStr
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []DepositAddress{addr}, nil
+}
+
+func (u *RespUnmarshaler) UnmarshalGetDepositHistoryResponse(data []byte) ([]WalletRecord, error) {
+	return u.unmarshalWalletRecords(data)
+}
+
+func (u *RespUnmarshaler) UnmarshalGetWithdrawHistoryResponse(data []byte) ([]WalletRecord, error) {
+	return u.unmarshalWalletRecords(data)
+}
+
+func (u *RespUnmarshaler) unmarshalWalletRecords(data []byte) ([]WalletRecord, error) {
+	var records []WalletRecord
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var record WalletRecord
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				record.Id = valStr
+			case "coin":
+				record.Coin = valStr
+			case "network":
+				record.Network = valStr
+			case "address":
+				record.Address = valStr
+			case "addressTag":
+				record.Tag = valStr
+			case "amount":
+				record.Amount = cast.ToFloat64(valStr)
+			case "transactionFee":
+				record.Fee = cast.ToFloat64(valStr)
+			case "txId":
+				record.TxId = valStr
+			case "status":
+				record.Status = valStr
+			case "insertTime": //充值记录
+				record.Timestamp = cast.ToInt64(valStr)
+			case "applyTime": //提币记录,格式 2019-10-12 11:12:02 (UTC)
+				if t, er := time.Parse("2006-01-02 15:04:05", valStr); er == nil {
+					record.Timestamp = t.UnixMilli()
+				}
+			}
+			return nil
+		})
+		records = append(records, record)
+	})
+
+	return records, err
+}
+
+func (u *RespUnmarshaler) UnmarshalWithdrawResponse(data []byte) (string, error) {
+	id, err := jsonparser.GetString(data, "id")
+	if err != nil {
+		return "", errors.New(string(data))
+	}
+	return id, nil
+}
@@ -0,0 +1,39 @@
+package spot
+
+import (
+	"testing"
+)
+
+func TestRespUnmarshaler_UnmarshalGetTradeFeeResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantMaker float64
+		wantTaker float64
+		wantErr   bool
+	}{
+		{
+			name:      "commission",
+			data:      `[{"symbol":"BTCUSDT","makerCommission":"0.001","takerCommission":"0.001"}]`,
+			wantMaker: 0.001,
+			wantTaker: 0.001,
+		},
+		{name: "empty", data: `[]`, wantErr: true},
+	}
+
+	u := new(RespUnmarshaler)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fee, err := u.UnmarshalGetTradeFeeResponse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if fee.Maker != tt.wantMaker || fee.Taker != tt.wantTaker {
+				t.Fatalf("fee = (%v, %v), want (%v, %v)", fee.Maker, fee.Taker, tt.wantMaker, tt.wantTaker)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/httpcli"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// GetCoinNetworks 交易所返回全部币种,coin不为空时在本地过滤
+func (s *PrvApi) GetCoinNetworks(coin string, opt ...OptionParameter) ([]CoinNetwork, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetCoinNetworksUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	networks, err := s.UnmarshalerOpts.GetCoinNetworksResponseUnmarshaler(data)
+	if err != nil || coin == "" {
+		return networks, data, err
+	}
+
+	filtered := make([]CoinNetwork, 0, 4)
+	for _, n := range networks {
+		if n.Coin == coin {
+			filtered = append(filtered, n)
+		}
+	}
+
+	return filtered, data, nil
+}
+
+// GetDepositAddress network为空时返回默认网络的地址
+func (s *PrvApi) GetDepositAddress(coin, network string, opt ...OptionParameter) ([]DepositAddress, []byte, error) {
+	params := url.Values{}
+	params.Set("coin", coin)
+	if network != "" {
+		params.Set("network", network)
+	}
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetDepositAddressUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	addresses, err := s.UnmarshalerOpts.GetDepositAddressResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range addresses {
+		addresses[i].Network = network
+	}
+
+	return addresses, data, nil
+}
+
+func (s *PrvApi) GetDepositHistory(coin string, opt ...OptionParameter) ([]WalletRecord, []byte, error) {
+	params := url.Values{}
+	if coin != "" {
+		params.Set("coin", coin)
+	}
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetDepositHistoryUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	records, err := s.UnmarshalerOpts.GetDepositHistoryResponseUnmarshaler(data)
+	return records, data, err
+}
+
+func (s *PrvApi) GetWithdrawHistory(coin string, opt ...OptionParameter) ([]WalletRecord, []byte, error) {
+	params := url.Values{}
+	if coin != "" {
+		params.Set("coin", coin)
+	}
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.GetWithdrawHistoryUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	records, err := s.UnmarshalerOpts.GetWithdrawHistoryResponseUnmarshaler(data)
+	return records, data, err
+}
+
+// Withdraw 手续费由交易所扣除,忽略req.Fee,必须通过options.WithWithdrawGuard设置安全检查
+func (s *PrvApi) Withdraw(req WithdrawRequest, opt ...OptionParameter) (string, []byte, error) {
+	return s.apiOpts.WithdrawGuard.Withdraw(req, func() (string, []byte, error) {
+		return s.withdraw(req, opt...)
+	})
+}
+
+func (s *PrvApi) withdraw(req WithdrawRequest, opt ...OptionParameter) (string, []byte, error) {
+	params := url.Values{}
+	params.Set("coin", req.Coin)
+	params.Set("network", req.Network)
+	params.Set("address", req.Address)
+	if req.Tag != "" {
+		params.Set("addressTag", req.Tag)
+	}
+	params.Set("amount", FloatToString(req.Amount, 8))
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.DoAuthRequest(http.MethodPost, fmt.Sprintf("%s%s", s.UriOpts.Endpoint, s.UriOpts.WithdrawUri), &params, nil)
+	if err != nil {
+		//4xx为参数、余额、限频等错误,交易所没有受理
+		if code := httpcli.StatusCode(err); code >= 400 && code < 500 {
+			err = options.WithdrawRejected(err)
+		}
+		return "", data, err
+	}
+
+	id, err := s.UnmarshalerOpts.WithdrawResponseUnmarshaler(data)
+	return id, data, err
+}
+
+func (s *PrvApi) CancelWithdraw(id string, opt ...OptionParameter) ([]byte, error) {
+	return nil, errors.New("binance not support cancel withdraw")
+}
@@ -0,0 +1,151 @@
+package clock
+
+import (
+	"errors"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"sync"
+	"time"
+)
+
+const defaultInterval = time.Minute
+
+// ServerTimeSource 提供交易所服务器时间(毫秒)的接口,各交易所的Spot/Futures实现了GetServerTime
+type ServerTimeSource interface {
+	GetServerTime(opt ...model.OptionParameter) (serverTime int64, responseBody []byte, err error)
+}
+
+// Service 定时采样交易所服务器时间,记录本地时钟的偏差和请求往返时间(RTT)
+// 实现了options.Clock,通过options.WithClock传给PrvApi后签名使用修正后的时间
+type Service struct {
+	source   ServerTimeSource
+	interval time.Duration
+	samples  int
+	logger   logger.ILogger
+
+	mu     sync.RWMutex
+	offset time.Duration
+	rtt    time.Duration
+	synced time.Time
+
+	stopCh chan struct{}
+	once   sync.Once
+}
+
+// NewService interval为采样周期,小于等于0时使用defaultInterval,每次采样请求3次取RTT最小的一次计算偏差,
+// l为nil时使用logger.Default()
+func NewService(source ServerTimeSource, interval time.Duration, l logger.ILogger) *Service {
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if l == nil {
+		l = logger.Default()
+	}
+	return &Service{
+		source:   source,
+		interval: interval,
+		samples:  3,
+		logger:   l,
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Start 先同步一次,然后在后台定时同步,首次同步失败时返回错误并且不会启动定时同步
+func (s *Service) Start() error {
+	if err := s.Sync(); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+				if err := s.Sync(); err != nil {
+					s.logger.Warn("sync server time failed", "error", err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (s *Service) Stop() {
+	s.once.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+// Sync 立即采样一次服务器时间
+func (s *Service) Sync() error {
+	var (
+		bestRtt    time.Duration = -1
+		bestOffset time.Duration
+		lastErr    error
+	)
+
+	for i := 0; i < s.samples; i++ {
+		t0 := time.Now()
+		serverTime, _, err := s.source.GetServerTime()
+		t1 := time.Now()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		rtt := t1.Sub(t0)
+		if bestRtt >= 0 && rtt >= bestRtt {
+			continue
+		}
+
+		//假设请求和响应耗时相同,服务器时间对应本地的t0+rtt/2
+		bestRtt = rtt
+		bestOffset = time.UnixMilli(serverTime).Sub(t0.Add(rtt / 2))
+	}
+
+	if bestRtt < 0 {
+		if lastErr == nil {
+			lastErr = errors.New("no server time sample")
+		}
+		return lastErr
+	}
+
+	s.mu.Lock()
+	s.offset = bestOffset
+	s.rtt = bestRtt
+	s.synced = time.Now()
+	s.mu.Unlock()
+
+	s.logger.Debug("server time synced", "offset", bestOffset, "rtt", bestRtt)
+
+	return nil
+}
+
+// Now 修正偏差后的当前时间
+func (s *Service) Now() time.Time {
+	return time.Now().Add(s.Offset())
+}
+
+// Offset 服务器时间减去本地时间
+func (s *Service) Offset() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.offset
+}
+
+func (s *Service) RTT() time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.rtt
+}
+
+// LastSynced 最后一次同步成功的时间,未同步时为零值
+func (s *Service) LastSynced() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.synced
+}
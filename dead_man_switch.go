@@ -0,0 +1,138 @@
+package goex
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"sync"
+	"time"
+)
+
+// DeadManSwitch 定时续期交易所的倒计时全部撤单,程序崩溃或者网络中断后不再续期,挂单会在timeout之后被交易所撤销
+type DeadManSwitch struct {
+	api      IDeadManSwitchPrvRest
+	pair     model.CurrencyPair
+	timeout  time.Duration
+	interval time.Duration
+	logger   logger.ILogger
+	onError  func(err error)
+
+	mu      sync.Mutex
+	running bool
+	stopCh  chan struct{}
+	doneCh  chan struct{}
+
+	errMu   sync.Mutex
+	lastErr error
+}
+
+// NewDeadManSwitch
+// @parameter
+//
+//	timeout  倒计时时长,必须大于0,取值范围还受交易所限制(如okx为[10s, 120s])
+//	interval 续期间隔,必须小于timeout,为0时默认timeout/3
+func NewDeadManSwitch(api IDeadManSwitchPrvRest, pair model.CurrencyPair, timeout, interval time.Duration) (*DeadManSwitch, error) {
+	if timeout <= 0 {
+		return nil, errors.New("dead man switch timeout must be greater than 0")
+	}
+	if interval <= 0 {
+		interval = timeout / 3
+	}
+	if interval <= 0 || interval >= timeout {
+		return nil, fmt.Errorf("dead man switch interval %s must be in (0, %s)", interval, timeout)
+	}
+	return &DeadManSwitch{
+		api:      api,
+		pair:     pair,
+		timeout:  timeout,
+		interval: interval,
+		logger:   logger.Default(),
+	}, nil
+}
+
+// SetLogger 续期失败的日志,l为nil时使用logger.Default(),需要在Start之前调用
+func (d *DeadManSwitch) SetLogger(l logger.ILogger) *DeadManSwitch {
+	if l == nil {
+		l = logger.Default()
+	}
+	d.logger = logger.Redact(l)
+	return d
+}
+
+// OnError 续期失败时的回调,在续期goroutine中同步调用,需要在Start之前调用
+func (d *DeadManSwitch) OnError(fn func(err error)) *DeadManSwitch {
+	d.onError = fn
+	return d
+}
+
+// Err 最近一次续期的错误,续期成功后重置为nil
+func (d *DeadManSwitch) Err() error {
+	d.errMu.Lock()
+	defer d.errMu.Unlock()
+	return d.lastErr
+}
+
+// Start 立即设置一次倒计时,成功后启动续期goroutine
+func (d *DeadManSwitch) Start() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.running {
+		return nil
+	}
+
+	_, err := d.api.CancelAllAfter(d.pair, d.timeout)
+	if err != nil {
+		return err
+	}
+
+	d.running = true
+	d.stopCh = make(chan struct{})
+	d.doneCh = make(chan struct{})
+	go d.keep(d.stopCh, d.doneCh)
+
+	return nil
+}
+
+// Stop 停止续期并取消交易所的倒计时
+func (d *DeadManSwitch) Stop() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.running {
+		return nil
+	}
+
+	close(d.stopCh)
+	<-d.doneCh
+	d.running = false
+
+	_, err := d.api.CancelAllAfter(d.pair, 0)
+	return err
+}
+
+func (d *DeadManSwitch) keep(stopCh <-chan struct{}, doneCh chan<- struct{}) {
+	defer close(doneCh)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			_, err := d.api.CancelAllAfter(d.pair, d.timeout)
+			d.errMu.Lock()
+			d.lastErr = err
+			d.errMu.Unlock()
+			if err != nil {
+				d.logger.Warn("dead man switch refresh cancel all after error", "pair", d.pair.Symbol, "error", err)
+				if d.onError != nil {
+					d.onError(err)
+				}
+			}
+		}
+	}
+}
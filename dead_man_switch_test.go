@@ -0,0 +1,150 @@
+package goex
+
+import (
+	"errors"
+	"github.com/nntaoli-project/goex/v2/model"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeCanceller struct {
+	mu       sync.Mutex
+	timeouts []time.Duration
+	errs     []error //按调用顺序返回的错误,用完后返回nil
+}
+
+func (f *fakeCanceller) CancelAllAfter(_ model.CurrencyPair, timeout time.Duration, _ ...model.OptionParameter) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.timeouts = append(f.timeouts, timeout)
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (f *fakeCanceller) calls() []time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]time.Duration(nil), f.timeouts...)
+}
+
+func TestNewDeadManSwitch(t *testing.T) {
+	tests := []struct {
+		name         string
+		timeout      time.Duration
+		interval     time.Duration
+		wantErr      bool
+		wantInterval time.Duration
+	}{
+		{name: "default interval", timeout: 30 * time.Second, wantInterval: 10 * time.Second},
+		{name: "custom interval", timeout: 30 * time.Second, interval: 5 * time.Second, wantInterval: 5 * time.Second},
+		{name: "zero timeout", wantErr: true},
+		{name: "interval not less than timeout", timeout: time.Second, interval: time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewDeadManSwitch(&fakeCanceller{}, model.CurrencyPair{}, tt.timeout, tt.interval)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && d.interval != tt.wantInterval {
+				t.Fatalf("interval = %s, want %s", d.interval, tt.wantInterval)
+			}
+		})
+	}
+}
+
+func TestDeadManSwitch_StartRefreshStop(t *testing.T) {
+	refreshErr := errors.New("refresh error")
+	api := &fakeCanceller{}
+	d, err := NewDeadManSwitch(api, model.CurrencyPair{Symbol: "BTC-USDT"}, time.Second, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errCh := make(chan error, 1)
+	d.OnError(func(err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	})
+
+	if err = d.Start(); err != nil {
+		t.Fatal(err)
+	}
+	//重复Start不会重复设置倒计时
+	if err = d.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	api.mu.Lock()
+	api.errs = []error{refreshErr}
+	api.mu.Unlock()
+
+	select {
+	case err = <-errCh:
+		if !errors.Is(err, refreshErr) {
+			t.Fatalf("OnError got %v, want %v", err, refreshErr)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("OnError was not called")
+	}
+
+	//失败后继续续期,成功后Err重置为nil
+	deadline := time.Now().Add(time.Second)
+	for d.Err() != nil {
+		if time.Now().After(deadline) {
+			t.Fatal("Err was not reset after a successful refresh")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err = d.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := api.calls()
+	if len(calls) < 3 {
+		t.Fatalf("CancelAllAfter called %d times, want at least 3", len(calls))
+	}
+	for i, timeout := range calls[:len(calls)-1] {
+		if timeout != time.Second {
+			t.Fatalf("call %d timeout = %s, want 1s", i, timeout)
+		}
+	}
+	if last := calls[len(calls)-1]; last != 0 {
+		t.Fatalf("Stop should cancel the countdown with timeout 0, got %s", last)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+	if n := len(api.calls()); n != len(calls) {
+		t.Fatalf("refresh continued after Stop: %d calls, want %d", n, len(calls))
+	}
+}
+
+func TestDeadManSwitch_StartError(t *testing.T) {
+	api := &fakeCanceller{errs: []error{errors.New("start error")}}
+	d, err := NewDeadManSwitch(api, model.CurrencyPair{}, time.Second, 10*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = d.Start(); err == nil {
+		t.Fatal("expected start error")
+	}
+	if err = d.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(api.calls()); n != 1 {
+		t.Fatalf("CancelAllAfter called %d times, want 1", n)
+	}
+}
@@ -2,9 +2,9 @@ package httpcli
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -73,9 +73,18 @@ func (cli *DefaultHttpClient) SetProxy(proxy string) error {
 }
 
 func (cli *DefaultHttpClient) DoRequest(method, rqUrl string, reqBody string, headers map[string]string) (data []byte, err error) {
+	return cli.DoRequestContext(context.Background(), method, rqUrl, reqBody, headers)
+}
+
+func (cli *DefaultHttpClient) DoRequestContext(ctx context.Context, method, rqUrl string, reqBody string, headers map[string]string) (data []byte, err error) {
 	logger.Debugf("[DefaultHttpClient] [%s] request url: %s", method, rqUrl)
 
-	reqTimeoutCtx, _ := context.WithTimeout(context.TODO(), cli.timeout)
+	defer func(start time.Time) {
+		metrics.ObserveRequest(metrics.HostOf(rqUrl), metrics.Layer_Http, method, rqUrl, start, err)
+	}(time.Now())
+
+	reqTimeoutCtx, cancel := context.WithTimeout(ctx, cli.timeout)
+	defer cancel()
 	req, _ := http.NewRequestWithContext(reqTimeoutCtx, method, rqUrl, strings.NewReader(reqBody))
 
 	if headers != nil {
@@ -102,7 +111,7 @@ func (cli *DefaultHttpClient) DoRequest(method, rqUrl string, reqBody string, he
 	}
 
 	if resp.StatusCode != 200 {
-		return bodyData, errors.New(resp.Status)
+		return bodyData, &HttpError{StatusCode: resp.StatusCode, Status: resp.Status}
 	}
 
 	return bodyData, nil
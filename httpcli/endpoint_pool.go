@@ -0,0 +1,247 @@
+package httpcli
+
+import (
+	"errors"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type endpoint struct {
+	base      string //scheme://host
+	rtt       time.Duration
+	probed    bool
+	downUntil time.Time
+}
+
+// EndpointPool 同一个交易所的多个备用域名,按探测的延迟选择最快的可用域名,
+// 连接失败或5xx时自动切换到下一个域名重试:
+//   - GET请求在连接错误和5xx时重试
+//   - 其它请求只在建立连接失败时重试,避免重复下单
+//
+// 请求url的域名不在池中时(例如自定义了Endpoint)直接请求,不做切换。
+// 没有调用Start时按添加的顺序使用域名,只在失败时切换
+type EndpointPool struct {
+	endpoints []*endpoint
+	probePath string
+	cooldown  time.Duration
+	logger    logger.ILogger
+
+	mu        sync.RWMutex
+	stopCh    chan struct{}
+	startOnce sync.Once
+	once      sync.Once
+}
+
+// NewEndpointPool probePath为探测延迟时请求的接口(一般为服务器时间接口),第一个endpoint为默认域名
+// l为nil时使用logger.Default()
+func NewEndpointPool(l logger.ILogger, probePath string, endpoints ...string) *EndpointPool {
+	if l == nil {
+		l = logger.Default()
+	}
+	pool := &EndpointPool{
+		probePath: probePath,
+		cooldown:  30 * time.Second,
+		logger:    l,
+		stopCh:    make(chan struct{}),
+	}
+	for _, e := range endpoints {
+		pool.endpoints = append(pool.endpoints, &endpoint{base: strings.TrimSuffix(e, "/")})
+	}
+	return pool
+}
+
+// WithCooldown 域名失败后在cooldown时间内排到最后
+func (p *EndpointPool) WithCooldown(cooldown time.Duration) *EndpointPool {
+	p.cooldown = cooldown
+	return p
+}
+
+// Do 使用可用的域名替换reqUrl的scheme和host后调用fn,fn中需要完成签名,
+// 部分交易所(huobi)的签名包含域名
+func (p *EndpointPool) Do(method, reqUrl string, fn func(reqUrl string) ([]byte, error)) ([]byte, error) {
+	if p == nil || len(p.endpoints) == 0 {
+		return fn(reqUrl)
+	}
+
+	base, path, ok := splitUrl(reqUrl)
+	if !ok || !p.contains(base) {
+		return fn(reqUrl)
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+
+	for _, candidate := range p.candidates() {
+		data, err = fn(candidate + path)
+		if err == nil || !isRetryable(method, err) {
+			return data, err
+		}
+		p.logger.Warn("endpoint request failed, try next endpoint", "method", method, "endpoint", candidate, "error", err)
+		p.markDown(candidate)
+	}
+
+	return data, err
+}
+
+// Probe 请求probePath探测所有域名的延迟
+func (p *EndpointPool) Probe() {
+	for _, e := range p.snapshot() {
+		start := time.Now()
+		_, err := Cli.DoRequest(http.MethodGet, e+p.probePath, "", nil)
+		rtt := time.Since(start)
+
+		if err != nil {
+			p.logger.Warn("probe endpoint failed", "endpoint", e, "error", err)
+			p.markDown(e)
+			continue
+		}
+
+		p.mu.Lock()
+		for _, ep := range p.endpoints {
+			if ep.base == e {
+				ep.rtt, ep.probed, ep.downUntil = rtt, true, time.Time{}
+			}
+		}
+		p.mu.Unlock()
+	}
+}
+
+// Start 立即探测一次延迟,之后后台按interval定时探测,重复调用无效。
+// 交易所实例可以通过options.WithEndpointProbe在构造时启动,不再使用时调用Stop
+func (p *EndpointPool) Start(interval time.Duration) {
+	if p == nil || interval <= 0 {
+		return
+	}
+	p.startOnce.Do(func() {
+		p.Probe()
+		go p.probeLoop(interval)
+	})
+}
+
+func (p *EndpointPool) probeLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case <-ticker.C:
+			p.Probe()
+		}
+	}
+}
+
+// Stop 停止后台探测
+func (p *EndpointPool) Stop() {
+	if p == nil {
+		return
+	}
+	p.once.Do(func() {
+		close(p.stopCh)
+	})
+}
+
+// Best 当前选择的域名
+func (p *EndpointPool) Best() string {
+	if c := p.candidates(); len(c) > 0 {
+		return c[0]
+	}
+	return ""
+}
+
+// candidates 可用的域名按延迟排序(未探测的保持原有顺序排在后面),冷却中的域名排在最后
+func (p *EndpointPool) candidates() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var (
+		now       = time.Now()
+		available []*endpoint
+		down      []*endpoint
+	)
+	for _, e := range p.endpoints {
+		if now.Before(e.downUntil) {
+			down = append(down, e)
+		} else {
+			available = append(available, e)
+		}
+	}
+
+	sort.SliceStable(available, func(i, j int) bool {
+		if available[i].probed != available[j].probed {
+			return available[i].probed
+		}
+		return available[i].rtt < available[j].rtt
+	})
+
+	bases := make([]string, 0, len(p.endpoints))
+	for _, e := range append(available, down...) {
+		bases = append(bases, e.base)
+	}
+	return bases
+}
+
+func (p *EndpointPool) snapshot() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	bases := make([]string, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		bases = append(bases, e.base)
+	}
+	return bases
+}
+
+func (p *EndpointPool) contains(base string) bool {
+	for _, e := range p.snapshot() {
+		if e == base {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *EndpointPool) markDown(base string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, e := range p.endpoints {
+		if e.base == base {
+			e.downUntil = time.Now().Add(p.cooldown)
+		}
+	}
+}
+
+func splitUrl(reqUrl string) (base, path string, ok bool) {
+	u, err := url.Parse(reqUrl)
+	if err != nil || u.Host == "" {
+		return "", "", false
+	}
+	base = u.Scheme + "://" + u.Host
+	return base, strings.TrimPrefix(reqUrl, base), true
+}
+
+func isRetryable(method string, err error) bool {
+	var httpErr *HttpError
+	if errors.As(err, &httpErr) {
+		return method == http.MethodGet && httpErr.StatusCode >= 500
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	if method != http.MethodGet {
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,149 @@
+package httpcli
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testEndpoint struct {
+	*httptest.Server
+	down  int32 //1为不可用
+	delay time.Duration
+	hits  int32
+}
+
+func newTestEndpoint(t *testing.T, delay time.Duration) *testEndpoint {
+	e := &testEndpoint{delay: delay}
+	e.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/time" {
+			time.Sleep(e.delay)
+		} else {
+			atomic.AddInt32(&e.hits, 1)
+		}
+		if atomic.LoadInt32(&e.down) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(r.Host))
+	}))
+	t.Cleanup(e.Close)
+	return e
+}
+
+func doGet(p *EndpointPool, method, reqUrl string) ([]byte, error) {
+	return p.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		return Cli.DoRequest(method, reqUrl, "", nil)
+	})
+}
+
+func TestEndpointPool_Failover(t *testing.T) {
+	a, b := newTestEndpoint(t, 0), newTestEndpoint(t, 0)
+	pool := NewEndpointPool(nil, "/time", a.URL, b.URL).WithCooldown(50 * time.Millisecond)
+
+	if pool.Best() != a.URL {
+		t.Fatalf("best = %s before probing, want the first endpoint %s", pool.Best(), a.URL)
+	}
+
+	atomic.StoreInt32(&a.down, 1)
+	data, err := doGet(pool, http.MethodGet, a.URL+"/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != b.Listener.Addr().String() {
+		t.Fatalf("GET should fail over to %s, got response from %s", b.URL, data)
+	}
+	if pool.Best() != b.URL {
+		t.Fatalf("best = %s after failure, want %s", pool.Best(), b.URL)
+	}
+
+	//冷却中的域名排在最后,后续请求直接使用b
+	atomic.StoreInt32(&a.hits, 0)
+	if _, err = doGet(pool, http.MethodGet, a.URL+"/api"); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&a.hits) != 0 {
+		t.Fatal("endpoint in cooldown should not be requested first")
+	}
+
+	//非GET请求在5xx时不重试,避免重复下单
+	atomic.StoreInt32(&b.down, 1)
+	atomic.StoreInt32(&b.hits, 0)
+	if _, err = doGet(pool, http.MethodPost, a.URL+"/api"); StatusCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("POST err = %v, want 503", err)
+	}
+	if atomic.LoadInt32(&b.hits) != 1 || atomic.LoadInt32(&a.hits) != 0 {
+		t.Fatalf("POST should not be retried, hits a=%d b=%d", atomic.LoadInt32(&a.hits), atomic.LoadInt32(&b.hits))
+	}
+	atomic.StoreInt32(&b.down, 0)
+
+	//冷却结束并且恢复后重新排在前面
+	atomic.StoreInt32(&a.down, 0)
+	time.Sleep(60 * time.Millisecond)
+	if pool.Best() != a.URL {
+		t.Fatalf("best = %s after cooldown, want %s", pool.Best(), a.URL)
+	}
+}
+
+func TestEndpointPool_DialErrorRetry(t *testing.T) {
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	b := newTestEndpoint(t, 0)
+	pool := NewEndpointPool(nil, "/time", closed.URL, b.URL)
+
+	//建立连接失败时非GET请求也会切换
+	data, err := doGet(pool, http.MethodPost, closed.URL+"/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != b.Listener.Addr().String() {
+		t.Fatalf("POST should fail over to %s after dial error, got %s", b.URL, data)
+	}
+}
+
+func TestEndpointPool_Probe(t *testing.T) {
+	slow, fast := newTestEndpoint(t, 30*time.Millisecond), newTestEndpoint(t, 0)
+	pool := NewEndpointPool(nil, "/time", slow.URL, fast.URL).WithCooldown(time.Minute)
+
+	pool.Start(time.Hour)
+	defer pool.Stop()
+	if pool.Best() != fast.URL {
+		t.Fatalf("best = %s after probing, want the fastest endpoint %s", pool.Best(), fast.URL)
+	}
+
+	//探测失败的域名进入冷却,恢复后下一次探测重新可用
+	atomic.StoreInt32(&fast.down, 1)
+	pool.Probe()
+	if pool.Best() != slow.URL {
+		t.Fatalf("best = %s after probe failure, want %s", pool.Best(), slow.URL)
+	}
+
+	atomic.StoreInt32(&fast.down, 0)
+	pool.Probe()
+	if pool.Best() != fast.URL {
+		t.Fatalf("best = %s after recovery, want %s", pool.Best(), fast.URL)
+	}
+}
+
+func TestEndpointPool_UnknownHost(t *testing.T) {
+	a := newTestEndpoint(t, 0)
+	pool := NewEndpointPool(nil, "/time", "https://api.example.com")
+
+	//域名不在池中时直接请求
+	data, err := doGet(pool, http.MethodGet, a.URL+"/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != a.Listener.Addr().String() {
+		t.Fatalf("unexpected response %s", data)
+	}
+
+	var nilPool *EndpointPool
+	nilPool.Start(time.Second)
+	nilPool.Stop()
+	if _, err = doGet(nilPool, http.MethodGet, a.URL+"/api"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,8 +1,9 @@
 package httpcli
 
 import (
-	"errors"
+	"fmt"
 	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/fasthttpproxy"
 	"time"
@@ -41,6 +42,10 @@ func (cli *FastHttpCli) DoRequest(method, rqUrl string, reqBody string, headers
 	//logger.Info("[fast http cli] use fasthttp client")
 	logger.Debug("[fast http cli]  req url:", rqUrl)
 
+	defer func(start time.Time) {
+		metrics.ObserveRequest(metrics.HostOf(rqUrl), metrics.Layer_Http, method, rqUrl, start, err)
+	}(time.Now())
+
 	req := fasthttp.AcquireRequest()
 	resp := fasthttp.AcquireResponse()
 	defer func() {
@@ -60,9 +65,12 @@ func (cli *FastHttpCli) DoRequest(method, rqUrl string, reqBody string, headers
 		return nil, err
 	}
 
+	//resp在返回后会被释放,需要复制一份响应体
+	body := append([]byte(nil), resp.Body()...)
+
 	if resp.StatusCode() != 200 {
-		return nil, errors.New(resp.String())
+		return body, &HttpError{StatusCode: resp.StatusCode(), Status: fmt.Sprintf("%d %s", resp.StatusCode(), fasthttp.StatusMessage(resp.StatusCode()))}
 	}
 
-	return resp.Body(), nil
+	return body, nil
 }
@@ -0,0 +1,15 @@
+package httpcli
+
+// HttpError http状态码不是200时返回的错误,Error()为状态行,例如: 503 Service Unavailable
+type HttpError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *HttpError) Error() string {
+	return e.Status
+}
+
+func (e *HttpError) HttpStatus() int {
+	return e.StatusCode
+}
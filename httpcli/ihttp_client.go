@@ -1,7 +1,23 @@
 package httpcli
 
+import "context"
+
 type IHttpClient interface {
 	SetTimeout(sec int64)
 	SetProxy(proxy string) error
 	DoRequest(method, rqUrl string, reqBody string, headers map[string]string) (data []byte, err error)
 }
+
+// IContextHttpClient 支持context的IHttpClient,ctx中带有交易所请求的trace span,取消ctx时中断请求
+type IContextHttpClient interface {
+	IHttpClient
+	DoRequestContext(ctx context.Context, method, rqUrl string, reqBody string, headers map[string]string) (data []byte, err error)
+}
+
+// DoRequestContext cli实现了IContextHttpClient时传入ctx,否则忽略ctx直接调用DoRequest
+func DoRequestContext(ctx context.Context, cli IHttpClient, method, rqUrl string, reqBody string, headers map[string]string) ([]byte, error) {
+	if ctxCli, ok := cli.(IContextHttpClient); ok && ctx != nil {
+		return ctxCli.DoRequestContext(ctx, method, rqUrl, reqBody, headers)
+	}
+	return cli.DoRequest(method, rqUrl, reqBody, headers)
+}
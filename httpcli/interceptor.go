@@ -0,0 +1,101 @@
+package httpcli
+
+import (
+	"context"
+	"time"
+)
+
+// Request 拦截器中的请求,PreRequestHook可以修改其中的内容
+type Request struct {
+	Ctx     context.Context //交易所请求的context,带有trace span(可用于注入traceparent等header),不会为nil
+	Method  string
+	Url     string
+	Headers map[string]string
+	Body    string
+}
+
+// Response 拦截器中的响应,PostResponseHook可以修改Body和Err
+type Response struct {
+	StatusCode int //请求失败且不是http状态码错误时为0
+	Body       []byte
+	Err        error
+	Start      time.Time
+	Latency    time.Duration
+}
+
+// PreRequestHook 请求前调用,返回error时不发送请求,直接返回该error(可用于故障注入)
+type PreRequestHook func(req *Request) error
+
+// PostResponseHook 请求后调用,包括请求失败和被PreRequestHook中断的请求
+type PostResponseHook func(req *Request, resp *Response)
+
+// InterceptorClient 在IHttpClient外面包装一层拦截器,例如:
+//
+//	cli := httpcli.NewInterceptorClient(httpcli.NewFastHttpCli()).
+//		UsePreRequest(func(req *httpcli.Request) error {
+//			req.Headers["X-Request-Id"] = uuid.NewString()
+//			return nil
+//		}).
+//		UsePostResponse(func(req *httpcli.Request, resp *httpcli.Response) {
+//			log.Println(req.Method, req.Url, resp.StatusCode, resp.Latency)
+//		})
+//	goex.SetDefaultHttpCli(cli)
+//
+// PreRequestHook按添加顺序调用,PostResponseHook按添加的逆序调用
+type InterceptorClient struct {
+	next IHttpClient
+	pre  []PreRequestHook
+	post []PostResponseHook
+}
+
+func NewInterceptorClient(next IHttpClient) *InterceptorClient {
+	return &InterceptorClient{next: next}
+}
+
+func (cli *InterceptorClient) UsePreRequest(hooks ...PreRequestHook) *InterceptorClient {
+	cli.pre = append(cli.pre, hooks...)
+	return cli
+}
+
+func (cli *InterceptorClient) UsePostResponse(hooks ...PostResponseHook) *InterceptorClient {
+	cli.post = append(cli.post, hooks...)
+	return cli
+}
+
+func (cli *InterceptorClient) SetTimeout(sec int64) {
+	cli.next.SetTimeout(sec)
+}
+
+func (cli *InterceptorClient) SetProxy(proxy string) error {
+	return cli.next.SetProxy(proxy)
+}
+
+func (cli *InterceptorClient) DoRequest(method, rqUrl string, reqBody string, headers map[string]string) ([]byte, error) {
+	return cli.DoRequestContext(context.Background(), method, rqUrl, reqBody, headers)
+}
+
+func (cli *InterceptorClient) DoRequestContext(ctx context.Context, method, rqUrl string, reqBody string, headers map[string]string) ([]byte, error) {
+	if headers == nil {
+		headers = make(map[string]string, 1)
+	}
+	req := &Request{Ctx: ctx, Method: method, Url: rqUrl, Headers: headers, Body: reqBody}
+	resp := &Response{Start: time.Now()}
+
+	for _, hook := range cli.pre {
+		if resp.Err = hook(req); resp.Err != nil {
+			break
+		}
+	}
+
+	if resp.Err == nil {
+		resp.Body, resp.Err = DoRequestContext(req.Ctx, cli.next, req.Method, req.Url, req.Body, req.Headers)
+	}
+	resp.Latency = time.Since(resp.Start)
+	resp.StatusCode = StatusCode(resp.Err)
+
+	for i := len(cli.post) - 1; i >= 0; i-- {
+		cli.post[i](req, resp)
+	}
+
+	return resp.Body, resp.Err
+}
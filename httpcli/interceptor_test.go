@@ -0,0 +1,45 @@
+package httpcli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type ctxKey struct{}
+
+func TestInterceptorClient_Context(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Header.Get("X-Trace")))
+	}))
+	defer srv.Close()
+
+	cli := NewInterceptorClient(NewDefaultHttpClient()).UsePreRequest(func(req *Request) error {
+		if v, ok := req.Ctx.Value(ctxKey{}).(string); ok {
+			req.Headers["X-Trace"] = v
+		}
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "span-1")
+	data, err := DoRequestContext(ctx, cli, http.MethodGet, srv.URL, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "span-1" {
+		t.Fatalf("context value not passed to the request hook, got %q", data)
+	}
+
+	//没有context时使用context.Background()
+	if data, err = cli.DoRequest(http.MethodGet, srv.URL, "", nil); err != nil || len(data) != 0 {
+		t.Fatalf("DoRequest = %q, %v", data, err)
+	}
+
+	//ctx取消后中断请求
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = DoRequestContext(canceled, cli, http.MethodGet, srv.URL, "", nil); err == nil {
+		t.Fatal("expected error for canceled context")
+	}
+}
@@ -0,0 +1,52 @@
+package httpcli
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"net/url"
+	"time"
+)
+
+// StatusCode err为nil时返回200,http状态码错误返回对应的状态码,其它错误返回0
+func StatusCode(err error) int {
+	if err == nil {
+		return 200
+	}
+	var httpErr *HttpError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode
+	}
+	return 0
+}
+
+// LogRequest 输出一次请求的结构化日志,endpoint不包含请求参数
+func LogRequest(l logger.ILogger, exchange, method, reqUrl string, start time.Time, err error) {
+	endpoint := reqUrl
+	if u, e := url.Parse(reqUrl); e == nil {
+		endpoint = u.Path
+	}
+
+	args := []any{
+		"exchange", exchange,
+		"method", method,
+		"endpoint", endpoint,
+		"latency", time.Since(start),
+		"status", StatusCode(err),
+	}
+	if err != nil {
+		l.Warn("request failed", append(args, "error", err)...)
+		return
+	}
+	l.Debug("request", args...)
+}
+
+const maxLogBodyLen = 2048
+
+// LogBody 日志中输出的响应体,超过2KB时截断
+func LogBody(data []byte) string {
+	if len(data) <= maxLogBodyLen {
+		return string(data)
+	}
+	return fmt.Sprintf("%s...(%d bytes)", data[:maxLogBodyLen], len(data))
+}
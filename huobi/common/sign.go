@@ -5,26 +5,39 @@ import (
 	"github.com/nntaoli-project/goex/v2/options"
 	"github.com/nntaoli-project/goex/v2/util"
 	"net/url"
-	"time"
 )
 
-func DoSignParam(httpMethod, reqUrl string, apiOpt options.ApiOptions) *url.Values {
+func DoSignParam(httpMethod, reqUrl string, apiOpt options.ApiOptions) (*url.Values, error) {
+	return DoSignQueryParam(httpMethod, reqUrl, nil, apiOpt)
+}
+
+// DoSignQueryParam GET请求的业务参数需要与签名参数一起排序签名,返回的参数直接作为query
+func DoSignQueryParam(httpMethod, reqUrl string, params url.Values, apiOpt options.ApiOptions) (*url.Values, error) {
+	cred, err := apiOpt.Credential()
+	if err != nil {
+		return nil, err
+	}
+	defer cred.Zero()
+
 	///////////////////// 参数签名 ////////////////////////
 	signParams := url.Values{}
-	signParams.Set("AccessKeyId", apiOpt.Key)
+	for k, v := range params {
+		signParams[k] = v
+	}
+	signParams.Set("AccessKeyId", string(cred.Key))
 	signParams.Set("SignatureMethod", "HmacSHA256")
 	signParams.Set("SignatureVersion", "2")
-	signParams.Set("Timestamp", time.Now().UTC().Format("2006-01-02T15:04:05"))
+	signParams.Set("Timestamp", apiOpt.Now().UTC().Format("2006-01-02T15:04:05"))
 
 	reqURL, _ := url.Parse(reqUrl)
 	path := reqURL.RequestURI()
 	domain := reqURL.Hostname()
 
 	payload := fmt.Sprintf("%s\n%s\n%s\n%s", httpMethod, domain, path, signParams.Encode())
-	sign, _ := util.HmacSHA256Base64Sign(apiOpt.Secret, payload)
+	sign, _ := util.HmacSHA256Base64SignBytes(cred.Secret, payload)
 
 	signParams.Set("Signature", sign)
 	///////////////////签名结束////////////////////
 
-	return &signParams
+	return &signParams, nil
 }
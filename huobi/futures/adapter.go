@@ -1,26 +1,41 @@
 package futures
 
 import (
+	"fmt"
 	. "github.com/nntaoli-project/goex/v2/model"
 )
 
-func AdaptSideToDirectionAndOffset(side OrderSide) (direction, offset string) {
-	switch side {
-	case Futures_OpenBuy:
-		return "buy", "open"
-	case Futures_OpenSell:
-		return "sell", "open"
-	case Futures_CloseBuy:
-		return "sell", "close"
-	case Futures_CloseSell:
-		return "buy", "close"
-	default:
-		panic("order side error")
+// AdaptSideToDirectionAndOffset 单向持仓模式下offset为both,平仓单使用reduce_only
+func AdaptSideToDirectionAndOffset(side OrderSide, posMode PositionMode) (direction, offset string, reduceOnly bool, err error) {
+	if posMode == PositionMode_OneWay {
+		switch side {
+		case Futures_OpenBuy:
+			return "buy", "both", false, nil
+		case Futures_OpenSell:
+			return "sell", "both", false, nil
+		case Futures_CloseBuy:
+			return "sell", "both", true, nil
+		case Futures_CloseSell:
+			return "buy", "both", true, nil
+		}
+	} else {
+		switch side {
+		case Futures_OpenBuy:
+			return "buy", "open", false, nil
+		case Futures_OpenSell:
+			return "sell", "open", false, nil
+		case Futures_CloseBuy:
+			return "sell", "close", false, nil
+		case Futures_CloseSell:
+			return "buy", "close", false, nil
+		}
 	}
+	return "", "", false, fmt.Errorf("order side %s error, futures side only is Futures_OpenBuy or Futures_OpenSell or Futures_CloseBuy or Futures_CloseSell", side)
 }
 
+// AdaptOffsetDirectionToOrderSide 单向持仓模式的offset为both,调用方需要把reduce_only的订单当作close传入
 func AdaptOffsetDirectionToOrderSide(offset, direction string) OrderSide {
-	if offset == "open" {
+	if offset == "open" || offset == "both" {
 		if direction == "sell" {
 			return Futures_OpenSell
 		}
@@ -63,3 +78,19 @@ func AdaptStatus(s int) OrderStatus {
 	}
 	return 1000
 }
+
+func adaptFinancialRecordType(ty string) BillType {
+	switch ty {
+	case "3", "4": //平多,平空
+		return BillType_Trade
+	case "5", "6", "7", "8", "10": //开平仓手续费,交割手续费
+		return BillType_Fee
+	case "11", "12": //强平多,强平空
+		return BillType_Liquidation
+	case "14", "15", "34", "35", "36", "37": //币币与合约、母子账户之间划转
+		return BillType_Transfer
+	case "30", "31": //资金费收入,资金费支出
+		return BillType_Funding
+	}
+	return BillType_Other
+}
@@ -0,0 +1,43 @@
+package futures
+
+import (
+	. "github.com/nntaoli-project/goex/v2/model"
+	"testing"
+)
+
+func TestAdaptSideToDirectionAndOffset(t *testing.T) {
+	tests := []struct {
+		side           OrderSide
+		posMode        PositionMode
+		wantDirection  string
+		wantOffset     string
+		wantReduceOnly bool
+		wantErr        bool
+	}{
+		{side: Futures_OpenBuy, posMode: PositionMode_OneWay, wantDirection: "buy", wantOffset: "both"},
+		{side: Futures_OpenSell, posMode: PositionMode_OneWay, wantDirection: "sell", wantOffset: "both"},
+		{side: Futures_CloseBuy, posMode: PositionMode_OneWay, wantDirection: "sell", wantOffset: "both", wantReduceOnly: true},
+		{side: Futures_CloseSell, posMode: PositionMode_OneWay, wantDirection: "buy", wantOffset: "both", wantReduceOnly: true},
+
+		{side: Futures_OpenBuy, posMode: PositionMode_Hedge, wantDirection: "buy", wantOffset: "open"},
+		{side: Futures_OpenSell, posMode: PositionMode_Hedge, wantDirection: "sell", wantOffset: "open"},
+		{side: Futures_CloseBuy, posMode: PositionMode_Hedge, wantDirection: "sell", wantOffset: "close"},
+		{side: Futures_CloseSell, posMode: PositionMode_Hedge, wantDirection: "buy", wantOffset: "close"},
+
+		{side: Spot_Buy, posMode: PositionMode_OneWay, wantErr: true},
+		{side: Spot_Sell, posMode: PositionMode_Hedge, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.side)+"/"+string(tt.posMode), func(t *testing.T) {
+			direction, offset, reduceOnly, err := AdaptSideToDirectionAndOffset(tt.side, tt.posMode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if direction != tt.wantDirection || offset != tt.wantOffset || reduceOnly != tt.wantReduceOnly {
+				t.Fatalf("got (%s, %s, %v), want (%s, %s, %v)",
+					direction, offset, reduceOnly, tt.wantDirection, tt.wantOffset, tt.wantReduceOnly)
+			}
+		})
+	}
+}
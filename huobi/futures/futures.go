@@ -1,6 +1,8 @@
 package futures
 
 import (
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/httpcli"
 	. "github.com/nntaoli-project/goex/v2/options"
 )
 
@@ -11,38 +13,74 @@ type Futures struct {
 type USDTSwap struct {
 	uriOpts         UriOptions
 	unmarshalerOpts UnmarshalerOptions
+	exOpts          ExchangeOptions
 }
 
-func New() *Futures {
+func New(opts ...ExchangeOption) *Futures {
 	return &Futures{
-		USDTSwapFutures: NewUSDTSwap(),
+		USDTSwapFutures: NewUSDTSwap(opts...),
 	}
 }
 
-func NewUSDTSwap() *USDTSwap {
+// NewUSDTSwap U本位永续合约没有测试网,非生产环境时所有请求都会返回错误,避免误在生产环境下单
+func NewUSDTSwap(opts ...ExchangeOption) *USDTSwap {
 	f := &USDTSwap{
+		exOpts: NewExchangeOptions(opts...),
 		uriOpts: UriOptions{
-			Endpoint:            "https://api.hbdm.com",
-			TickerUri:           "/linear-swap-ex/market/detail/merged",
-			DepthUri:            "/linear-swap-ex/market/depth",
-			KlineUri:            "/linear-swap-ex/market/history/kline",
-			GetOrderUri:         "/linear-swap-api/v1/swap_cross_order_info",
-			GetPendingOrdersUri: "/linear-swap-api/v1/swap_cross_openorders",
-			GetHistoryOrdersUri: "/linear-swap-api/v3/swap_cross_hisorders",
-			CancelOrderUri:      "/linear-swap-api/v1/swap_cross_cancel",
-			NewOrderUri:         "/linear-swap-api/v1/swap_cross_order",
+			Endpoint:                  "https://api.hbdm.com",
+			TickerUri:                 "/linear-swap-ex/market/detail/merged",
+			GetServerTimeUri:          "/api/v1/timestamp",
+			DepthUri:                  "/linear-swap-ex/market/depth",
+			KlineUri:                  "/linear-swap-ex/market/history/kline",
+			GetTradesUri:              "/linear-swap-ex/market/history/trade",
+			GetFundingRateUri:         "/linear-swap-api/v1/swap_funding_rate",
+			GetFundingRateHistoryUri:  "/linear-swap-api/v1/swap_historical_funding_rate",
+			GetMarkPriceUri:           "/index/market/history/linear_swap_mark_price_kline",
+			GetIndexPriceUri:          "/linear-swap-api/v1/swap_index",
+			GetOpenInterestHistoryUri: "/linear-swap-api/v1/swap_his_open_interest",
+			GetLongShortRatioUri:      "/linear-swap-api/v1/swap_elite_account_ratio",
+			SetLeverageUri:            "/linear-swap-api/v1/swap_cross_switch_lever_rate",
+			GetLeverageUri:            "/linear-swap-api/v1/swap_cross_account_info",
+			SetPositionModeUri:        "/linear-swap-api/v1/swap_cross_switch_position_mode",
+			GetPositionModeUri:        "/linear-swap-api/v1/swap_cross_account_info",
+			GetOrderUri:               "/linear-swap-api/v1/swap_cross_order_info",
+			GetPendingOrdersUri:       "/linear-swap-api/v1/swap_cross_openorders",
+			GetHistoryOrdersUri:       "/linear-swap-api/v3/swap_cross_hisorders",
+			CancelOrderUri:            "/linear-swap-api/v1/swap_cross_cancel",
+			NewOrderUri:               "/linear-swap-api/v1/swap_cross_order",
+			NewBatchOrdersUri:         "/linear-swap-api/v1/swap_cross_batchorder",
+			CancelBatchOrdersUri:      "/linear-swap-api/v1/swap_cross_cancel",
+			CancelAllOrdersUri:        "/linear-swap-api/v1/swap_cross_cancelall",
+			GetFillsUri:               "/linear-swap-api/v3/swap_cross_matchresults",
+			GetBillsUri:               "/linear-swap-api/v3/swap_financial_record",
 		},
 		unmarshalerOpts: UnmarshalerOptions{
-			ResponseUnmarshaler:                 UnmarshalResponse,
-			KlineUnmarshaler:                    UnmarshalKline,
-			TickerUnmarshaler:                   UnmarshalTicker,
-			CancelOrderResponseUnmarshaler:      UnmarshalCancelOrderResponse,
-			CreateOrderResponseUnmarshaler:      UnmarshalCreateOrderResponse,
-			GetOrderInfoResponseUnmarshaler:     UnmarshalGetOrderInfoResponse,
-			GetPendingOrdersResponseUnmarshaler: UnmarshalGetPendingOrdersResponse,
-			GetHistoryOrdersResponseUnmarshaler: UnmarshalGetHistoryOrdersResponse,
+			ResponseUnmarshaler:                       UnmarshalResponse,
+			GetServerTimeResponseUnmarshaler:          UnmarshalGetServerTimeResponse,
+			KlineUnmarshaler:                          UnmarshalKline,
+			TickerUnmarshaler:                         UnmarshalTicker,
+			GetTradesResponseUnmarshaler:              UnmarshalGetTradesResponse,
+			GetFundingRateResponseUnmarshaler:         UnmarshalGetFundingRateResponse,
+			GetFundingRateHistoryResponseUnmarshaler:  UnmarshalGetFundingRateHistoryResponse,
+			GetMarkPriceResponseUnmarshaler:           UnmarshalGetMarkPriceResponse,
+			GetIndexPriceResponseUnmarshaler:          UnmarshalGetIndexPriceResponse,
+			GetOpenInterestHistoryResponseUnmarshaler: UnmarshalGetOpenInterestHistoryResponse,
+			GetLongShortRatioResponseUnmarshaler:      UnmarshalGetLongShortRatioResponse,
+			GetLeverageResponseUnmarshaler:            UnmarshalGetLeverageResponse,
+			GetPositionModeResponseUnmarshaler:        UnmarshalGetPositionModeResponse,
+			CancelOrderResponseUnmarshaler:            UnmarshalCancelOrderResponse,
+			CreateOrderResponseUnmarshaler:            UnmarshalCreateOrderResponse,
+			GetOrderInfoResponseUnmarshaler:           UnmarshalGetOrderInfoResponse,
+			GetPendingOrdersResponseUnmarshaler:       UnmarshalGetPendingOrdersResponse,
+			GetHistoryOrdersResponseUnmarshaler:       UnmarshalGetHistoryOrdersResponse,
+			CreateOrdersResponseUnmarshaler:           UnmarshalCreateOrdersResponse,
+			CancelOrdersResponseUnmarshaler:           UnmarshalCancelOrdersResponse,
+			GetFillsResponseUnmarshaler:               UnmarshalGetFillsResponse,
+			GetBillsResponseUnmarshaler:               UnmarshalGetBillsResponse,
 		},
 	}
+	f.uriOpts.EndpointPool = httpcli.NewEndpointPool(f.exOpts.Logger, f.uriOpts.GetServerTimeUri, f.uriOpts.Endpoint, "https://api.hbdm.vn")
+	f.uriOpts.EndpointPool.Start(f.exOpts.EndpointProbeInterval)
 	return f
 }
 
@@ -60,6 +98,13 @@ func (f *USDTSwap) WithUriOptions(uriOpts ...UriOption) *USDTSwap {
 	return f
 }
 
+func (f *USDTSwap) checkEnv() error {
+	if f.exOpts.IsSandbox() {
+		return fmt.Errorf("huobi usdt swap not support %s environment", f.exOpts.Env)
+	}
+	return nil
+}
+
 func (f *USDTSwap) NewUSDTSwapPrvApi(apiOpts ...ApiOption) *USDTSwapPrvApi {
 	prv := NewUSDTSwapPrvApi(apiOpts...)
 	prv.USDTSwap = f
@@ -0,0 +1,53 @@
+package futures
+
+import (
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/options"
+	"strings"
+	"testing"
+)
+
+func TestUSDTSwap_SandboxNotSupported(t *testing.T) {
+	pair := CurrencyPair{Symbol: "BTC-USDT"}
+
+	for _, env := range []Environment{Env_Demo, Env_Testnet} {
+		swap := NewUSDTSwap(WithEnvironment(env))
+
+		if _, _, err := swap.GetTicker(pair); err == nil {
+			t.Fatalf("%s: public request should fail", env)
+		}
+		if _, _, err := swap.GetServerTime(); err == nil {
+			t.Fatalf("%s: public request should fail", env)
+		}
+
+		prv := swap.NewUSDTSwapPrvApi(WithApiKey("key"), WithApiSecretKey("secret"))
+		if _, _, err := prv.GetPendingOrders(pair); err == nil {
+			t.Fatalf("%s: private request should fail", env)
+		}
+	}
+}
+
+func TestUSDTSwapPrvApi_LeverRate(t *testing.T) {
+	pair := CurrencyPair{Symbol: "BTC-USDT"}
+	prv := NewUSDTSwap().NewUSDTSwapPrvApi(WithApiKey("key"), WithApiSecretKey("secret"),
+		WithPositionMode(PositionMode_OneWay))
+
+	for _, lever := range []float64{0, -1, 2.5} {
+		if _, err := prv.SetLeverage(pair, lever); err == nil {
+			t.Fatalf("lever %v should be rejected", lever)
+		}
+	}
+
+	//没有设置过杠杆倍数时不能下单
+	if _, _, err := prv.CreateOrder(pair, 1, 1, Futures_OpenBuy, OrderType_Limit); err == nil || !strings.Contains(err.Error(), "lever rate") {
+		t.Fatalf("create order without lever rate should fail, got %v", err)
+	}
+	if _, _, err := prv.CreateOrders([]Order{{Pair: pair, Qty: 1, Price: 1, Side: Futures_OpenBuy, OrderTy: OrderType_Limit}}); err == nil || !strings.Contains(err.Error(), "lever rate") {
+		t.Fatalf("create orders without lever rate should fail, got %v", err)
+	}
+
+	prv.leverRates.Store(pair.Symbol, "5")
+	if leverRate, err := prv.getLeverRate(pair); err != nil || leverRate != "5" {
+		t.Fatalf("getLeverRate = %s, %v", leverRate, err)
+	}
+}
@@ -3,15 +3,22 @@ package futures
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/buger/jsonparser"
 	. "github.com/nntaoli-project/goex/v2/model"
 	"github.com/spf13/cast"
+	"math"
+	"strings"
 )
 
 func UnmarshalResponse(data []byte, i interface{}) error {
 	return json.Unmarshal(data, i)
 }
 
+func UnmarshalGetServerTimeResponse(data []byte) (int64, error) {
+	return jsonparser.GetInt(data, "ts")
+}
+
 func UnmarshalKline(data []byte) ([]Kline, error) {
 	var lines []Kline
 	klineData, _, _, err := jsonparser.Get(data, "data")
@@ -100,6 +107,72 @@ func UnmarshalCreateOrderResponse(data []byte) (*Order, error) {
 	return order, nil
 }
 
+func UnmarshalCreateOrdersResponse(data []byte) ([]BatchOrderResult, error) {
+	var results []BatchOrderResult
+
+	//index从1开始,与请求中orders_data的顺序对应
+	setResult := func(index int64, result BatchOrderResult) {
+		for int64(len(results)) < index {
+			results = append(results, BatchOrderResult{})
+		}
+		if index > 0 {
+			results[index-1] = result
+		}
+	}
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		index, _ := jsonparser.GetInt(value, "index")
+		errCode, _ := jsonparser.GetInt(value, "err_code")
+		errMsg, _ := jsonparser.GetString(value, "err_msg")
+		setResult(index, BatchOrderResult{Err: fmt.Errorf("err_code=%d, err_msg=%s", errCode, errMsg)})
+	}, "errors")
+	if err != nil && err != jsonparser.KeyPathNotFoundError {
+		return nil, err
+	}
+
+	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		index, _ := jsonparser.GetInt(value, "index")
+		ord, err := UnmarshalCreateOrderResponse(value)
+		if err != nil {
+			setResult(index, BatchOrderResult{Err: err})
+			return
+		}
+		setResult(index, BatchOrderResult{Order: *ord})
+	}, "success")
+	if err != nil && err != jsonparser.KeyPathNotFoundError {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func UnmarshalCancelOrdersResponse(data []byte) ([]BatchOrderResult, error) {
+	var results []BatchOrderResult
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		orderId, _ := jsonparser.GetString(value, "order_id")
+		errCode, _ := jsonparser.GetInt(value, "err_code")
+		errMsg, _ := jsonparser.GetString(value, "err_msg")
+		results = append(results, BatchOrderResult{
+			Order: Order{Id: orderId},
+			Err:   fmt.Errorf("err_code=%d, err_msg=%s", errCode, errMsg),
+		})
+	}, "errors")
+	if err != nil && err != jsonparser.KeyPathNotFoundError {
+		return nil, err
+	}
+
+	successes, _ := jsonparser.GetString(data, "successes")
+	for _, id := range strings.Split(successes, ",") {
+		if id == "" {
+			continue
+		}
+		results = append(results, BatchOrderResult{Order: Order{Id: id}})
+	}
+
+	return results, nil
+}
+
 func UnmarshalCancelOrderResponse(data []byte) error {
 	val, _, _, _ := jsonparser.Get(data, "errors")
 	if val != nil && len(val) > 0 {
@@ -143,6 +216,7 @@ func unmarshalOrderResponse(data []byte) (*Order, error) {
 	var (
 		order                  = new(Order)
 		orderOffset, direction string
+		reduceOnly             bool
 	)
 
 	err := jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
@@ -171,6 +245,8 @@ func unmarshalOrderResponse(data []byte) (*Order, error) {
 			direction = string(value)
 		case "offset":
 			orderOffset = string(value)
+		case "reduce_only":
+			reduceOnly = string(value) == "1"
 		}
 		return nil
 	})
@@ -179,6 +255,9 @@ func unmarshalOrderResponse(data []byte) (*Order, error) {
 		return nil, err
 	}
 
+	if orderOffset == "both" && reduceOnly {
+		orderOffset = "close"
+	}
 	order.Side = AdaptOffsetDirectionToOrderSide(orderOffset, direction)
 
 	return order, nil
@@ -192,10 +271,271 @@ func UnmarshalGetHistoryOrdersResponse(data []byte) ([]Order, error) {
 	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
 		ord, err := unmarshalOrderResponse(value)
 		if err != nil {
-			err = err
 			return
 		}
 		orders = append(orders, *ord)
 	})
 	return orders, err
 }
+
+func UnmarshalGetFillsResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			trade                  Trade
+			orderOffset, direction string
+			reduceOnly             bool
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "match_id":
+				trade.Tid = valStr
+			case "order_id_str":
+				trade.OrderId = valStr
+			case "trade_price":
+				trade.Price = cast.ToFloat64(valStr)
+			case "trade_volume":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "trade_fee":
+				trade.Fee = -cast.ToFloat64(valStr) //火币手续费扣除为负数
+			case "fee_asset":
+				trade.FeeCcy = valStr
+			case "role":
+				trade.IsMaker = valStr == "maker"
+			case "created_at":
+				trade.Timestamp = cast.ToInt64(valStr)
+			case "direction":
+				direction = valStr
+			case "offset":
+				orderOffset = valStr
+			case "reduce_only":
+				reduceOnly = valStr == "1"
+			}
+			return nil
+		})
+		if orderOffset == "both" && reduceOnly {
+			orderOffset = "close"
+		}
+		trade.Side = AdaptOffsetDirectionToOrderSide(orderOffset, direction)
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
+
+func UnmarshalGetTradesResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		_, err = jsonparser.ArrayEach(value, func(item []byte, dataType jsonparser.ValueType, offset int, err error) {
+			var trade Trade
+			_ = jsonparser.ObjectEach(item, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+				valStr := string(val)
+				switch string(key) {
+				case "id":
+					trade.Tid = valStr
+				case "price":
+					trade.Price = cast.ToFloat64(valStr)
+				case "amount":
+					trade.Qty = cast.ToFloat64(valStr)
+				case "direction":
+					if valStr == "sell" {
+						trade.Side = Spot_Sell
+					} else {
+						trade.Side = Spot_Buy
+					}
+				case "ts":
+					trade.Timestamp = cast.ToInt64(valStr)
+				}
+				return nil
+			})
+			trades = append(trades, trade)
+		}, "data")
+	}, "data")
+
+	return trades, err
+}
+
+func UnmarshalGetFundingRateResponse(data []byte) (*FundingRate, error) {
+	rateData, _, _, err := jsonparser.Get(data, "data")
+	if err != nil {
+		return nil, err
+	}
+
+	rate := unmarshalFundingRate(rateData)
+	rate.Timestamp, _ = jsonparser.GetInt(data, "ts")
+
+	return &rate, nil
+}
+
+func UnmarshalGetFundingRateHistoryResponse(data []byte) ([]FundingRate, error) {
+	var rates []FundingRate
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		rate := unmarshalFundingRate(value)
+		rates = append(rates, rate)
+	}, "data", "data")
+
+	return rates, err
+}
+
+func unmarshalFundingRate(data []byte) FundingRate {
+	var (
+		rate         FundingRate
+		realizedRate string
+	)
+
+	_ = jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "funding_rate":
+			rate.Rate = cast.ToFloat64(valStr)
+		case "realized_rate":
+			realizedRate = valStr
+		case "estimated_rate":
+			rate.NextRate = cast.ToFloat64(valStr)
+		case "funding_time":
+			rate.FundingTime = cast.ToInt64(valStr)
+		case "next_funding_time":
+			rate.NextFundingTime = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+
+	if realizedRate != "" { //历史数据取实际收取的费率
+		rate.Rate = cast.ToFloat64(realizedRate)
+	}
+
+	return rate
+}
+
+// UnmarshalGetMarkPriceResponse 解析标记价格K线,取最新一根的收盘价
+func UnmarshalGetMarkPriceResponse(data []byte) (*MarkPrice, error) {
+	closePx, err := jsonparser.GetString(data, "data", "[0]", "close")
+	if err != nil {
+		return nil, err
+	}
+
+	price := new(MarkPrice)
+	price.Price = cast.ToFloat64(closePx)
+	price.Timestamp, _ = jsonparser.GetInt(data, "ts")
+
+	return price, nil
+}
+
+func UnmarshalGetIndexPriceResponse(data []byte) (*IndexPrice, error) {
+	indexPx, err := jsonparser.GetFloat(data, "data", "[0]", "index_price")
+	if err != nil {
+		return nil, err
+	}
+
+	price := new(IndexPrice)
+	price.Price = indexPx
+	price.Timestamp, _ = jsonparser.GetInt(data, "data", "[0]", "index_ts")
+
+	return price, nil
+}
+
+func UnmarshalGetOpenInterestHistoryResponse(data []byte) ([]OpenInterest, error) {
+	var ois []OpenInterest
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var oi OpenInterest
+		_ = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "volume":
+				oi.Qty = cast.ToFloat64(valStr)
+			case "value":
+				oi.Value = cast.ToFloat64(valStr)
+			case "ts":
+				oi.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		ois = append(ois, oi)
+	}, "data", "tick")
+
+	return ois, err
+}
+
+func UnmarshalGetLongShortRatioResponse(data []byte) ([]LongShortRatio, error) {
+	var ratios []LongShortRatio
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var ratio LongShortRatio
+		_ = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "buy_ratio":
+				ratio.LongRatio = cast.ToFloat64(valStr)
+			case "sell_ratio":
+				ratio.ShortRatio = cast.ToFloat64(valStr)
+			case "ts":
+				ratio.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		if ratio.ShortRatio > 0 {
+			ratio.Ratio = ratio.LongRatio / ratio.ShortRatio
+		}
+		ratios = append(ratios, ratio)
+	}, "data", "list")
+
+	return ratios, err
+}
+
+// UnmarshalGetLeverageResponse 解析全仓账户信息中单个合约的contract_detail
+func UnmarshalGetLeverageResponse(data []byte) (float64, error) {
+	return jsonparser.GetFloat(data, "lever_rate")
+}
+
+// UnmarshalGetPositionModeResponse 解析全仓账户信息中的position_mode
+func UnmarshalGetPositionModeResponse(data []byte) (PositionMode, error) {
+	mode, err := jsonparser.GetString(data, "[0]", "position_mode")
+	if err != nil {
+		return "", err
+	}
+	switch mode {
+	case "dual_side":
+		return PositionMode_Hedge, nil
+	case "single_side":
+		return PositionMode_OneWay, nil
+	}
+	return "", errors.New("unknown position_mode: " + mode)
+}
+
+func UnmarshalGetBillsResponse(data []byte) ([]Bill, error) {
+	var bills []Bill
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var bill Bill
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				bill.Id = valStr
+			case "asset":
+				bill.Coin = valStr
+			case "contract_code":
+				bill.Symbol = valStr
+			case "type":
+				bill.RawType = valStr
+				bill.Type = adaptFinancialRecordType(valStr)
+			case "amount":
+				bill.Amount = cast.ToFloat64(valStr)
+			case "ts":
+				bill.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		if bill.Type == BillType_Fee {
+			bill.Fee = math.Abs(bill.Amount)
+		}
+		bills = append(bills, bill)
+	})
+
+	return bills, err
+}
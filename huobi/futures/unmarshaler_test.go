@@ -0,0 +1,32 @@
+package futures
+
+import (
+	. "github.com/nntaoli-project/goex/v2/model"
+	"testing"
+)
+
+func TestUnmarshalGetPositionModeResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    PositionMode
+		wantErr bool
+	}{
+		{name: "hedge", data: `[{"margin_account":"USDT","position_mode":"dual_side"}]`, want: PositionMode_Hedge},
+		{name: "one-way", data: `[{"margin_account":"USDT","position_mode":"single_side"}]`, want: PositionMode_OneWay},
+		{name: "unknown", data: `[{"position_mode":"other"}]`, wantErr: true},
+		{name: "empty", data: `[]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, err := UnmarshalGetPositionModeResponse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mode != tt.want {
+				t.Fatalf("mode = %s, want %s", mode, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package futures
+
+import (
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// GetBills 合约财务记录,coin为保证金账户(全仓为USDT),分页时通过opts传入from_id和direct=next
+func (f *USDTSwapPrvApi) GetBills(coin string, opts ...OptionParameter) ([]Bill, []byte, error) {
+	if coin == "" {
+		coin = "USDT"
+	}
+
+	params := url.Values{}
+	params.Set("mar_acct", coin)
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetBillsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	bills, err := f.unmarshalerOpts.GetBillsResponseUnmarshaler(data)
+	return bills, data, err
+}
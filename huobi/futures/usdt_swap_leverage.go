@@ -0,0 +1,147 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	"github.com/buger/jsonparser"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"math"
+	"net/http"
+	"net/url"
+)
+
+// SetLeverage 设置全仓模式的杠杆倍数,只支持整数倍,设置成功后该合约下单默认使用此杠杆倍数
+func (f *USDTSwapPrvApi) SetLeverage(pair CurrencyPair, lever float64, opts ...OptionParameter) ([]byte, error) {
+	if lever <= 0 || lever != math.Trunc(lever) {
+		return nil, fmt.Errorf("lever %v error, huobi usdt swap only support integer lever", lever)
+	}
+	leverRate := fmt.Sprint(int(lever))
+
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	params.Set("lever_rate", leverRate)
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.SetLeverageUri), &params, nil)
+	if err != nil {
+		return data, err
+	}
+
+	f.leverRates.Store(pair.Symbol, leverRate)
+
+	return data, nil
+}
+
+// GetLeverage 从全仓账户信息的contract_detail中获取合约的杠杆倍数
+func (f *USDTSwapPrvApi) GetLeverage(pair CurrencyPair, opts ...OptionParameter) (float64, []byte, error) {
+	params := url.Values{}
+	params.Set("margin_account", "USDT")
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetLeverageUri), &params, nil)
+	if err != nil {
+		return 0, data, err
+	}
+
+	var detail []byte
+	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if code, _ := jsonparser.GetString(value, "contract_code"); code == pair.Symbol {
+			detail = value
+		}
+	}, "[0]", "contract_detail")
+	if err != nil {
+		return 0, data, err
+	}
+
+	if detail == nil {
+		return 0, data, errors.New("not found contract detail of " + pair.Symbol)
+	}
+
+	lever, err := f.unmarshalerOpts.GetLeverageResponseUnmarshaler(detail)
+	if err != nil {
+		return 0, data, err
+	}
+
+	f.leverRates.Store(pair.Symbol, fmt.Sprint(lever))
+
+	return lever, data, nil
+}
+
+// SetMarginMode 当前只接入了全仓(swap_cross_*)接口,只做本地检查不发送请求:
+// MarginMode_Cross返回(nil, nil),其他模式返回错误
+func (f *USDTSwapPrvApi) SetMarginMode(pair CurrencyPair, mode MarginMode, opts ...OptionParameter) ([]byte, error) {
+	if mode != MarginMode_Cross {
+		return nil, errors.New("huobi usdt swap only support MarginMode_Cross")
+	}
+	return nil, nil
+}
+
+// SetPositionMode 按保证金账户(默认USDT)生效,设置成功后下单按对应的模式转换买卖方向
+func (f *USDTSwapPrvApi) SetPositionMode(mode PositionMode, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	params.Set("margin_account", "USDT")
+	switch mode {
+	case PositionMode_Hedge:
+		params.Set("position_mode", "dual_side")
+	case PositionMode_OneWay:
+		params.Set("position_mode", "single_side")
+	default:
+		return nil, errors.New("position mode only is PositionMode_Hedge or PositionMode_OneWay")
+	}
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.SetPositionModeUri), &params, nil)
+	if err != nil {
+		return data, err
+	}
+
+	f.posModeMu.Lock()
+	f.posMode = mode
+	f.posModeMu.Unlock()
+
+	return data, nil
+}
+
+// GetPositionMode 从全仓账户信息中获取保证金账户(默认USDT)的持仓模式
+func (f *USDTSwapPrvApi) GetPositionMode(opts ...OptionParameter) (PositionMode, []byte, error) {
+	params := url.Values{}
+	params.Set("margin_account", "USDT")
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetPositionModeUri), &params, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	mode, err := f.unmarshalerOpts.GetPositionModeResponseUnmarshaler(data)
+	return mode, data, err
+}
+
+// positionMode 下单使用的持仓模式,没有设置时查询一次交易所并缓存
+func (f *USDTSwapPrvApi) positionMode() (PositionMode, error) {
+	f.posModeMu.Lock()
+	defer f.posModeMu.Unlock()
+
+	if f.posMode == "" {
+		mode, _, err := f.GetPositionMode()
+		if err != nil {
+			return "", err
+		}
+		f.posMode = mode
+	}
+
+	return f.posMode, nil
+}
+
+// getLeverRate 下单未传入lever_rate时使用SetLeverage/GetLeverage缓存的杠杆倍数,没有缓存时返回错误
+func (f *USDTSwapPrvApi) getLeverRate(pair CurrencyPair) (string, error) {
+	if v, ok := f.leverRates.Load(pair.Symbol); ok {
+		return v.(string), nil
+	}
+	return "", fmt.Errorf("lever rate of %s unknown, please call SetLeverage/GetLeverage or pass lever_rate by opts", pair.Symbol)
+}
@@ -7,12 +7,16 @@ import (
 	"fmt"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
 	"github.com/nntaoli-project/goex/v2/huobi/common"
-	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	. "github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/tracing"
 	. "github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 type BaseResponse struct {
@@ -30,7 +34,11 @@ type TradeBaseResponse struct {
 
 type USDTSwapPrvApi struct {
 	*USDTSwap
-	apiOpts options.ApiOptions
+	apiOpts    options.ApiOptions
+	leverRates sync.Map //contract_code -> lever_rate,SetLeverage设置后下单默认使用
+
+	posModeMu sync.Mutex
+	posMode   PositionMode //持仓模式,通过WithPositionMode、SetPositionMode设置或者下单时查询
 }
 
 func NewUSDTSwapPrvApi(apiOpts ...options.ApiOption) *USDTSwapPrvApi {
@@ -38,6 +46,7 @@ func NewUSDTSwapPrvApi(apiOpts ...options.ApiOption) *USDTSwapPrvApi {
 	for _, opt := range apiOpts {
 		opt(&f.apiOpts)
 	}
+	f.posMode = f.apiOpts.PositionMode
 	return f
 }
 
@@ -48,15 +57,29 @@ func (f *USDTSwapPrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side
 	params.Set("volume", FloatToString(qty, pair.QtyPrecision))
 	params.Set("order_price_type", string(orderTy))
 
-	direction, offset := AdaptSideToDirectionAndOffset(side)
+	posMode, err := f.positionMode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	direction, offset, reduceOnly, err := AdaptSideToDirectionAndOffset(side, posMode)
+	if err != nil {
+		return nil, nil, err
+	}
 	params.Set("direction", direction)
 	params.Set("offset", offset)
+	if reduceOnly {
+		params.Set("reduce_only", "1")
+	}
 
 	MergeOptionParams(&params, opts...)
 
 	if params.Get("lever_rate") == "" {
-		logger.Warnf("[create order] set default lever rate 10")
-		params.Set("lever_rate", "10") //set default 10 lever rate
+		leverRate, err := f.getLeverRate(pair)
+		if err != nil {
+			return nil, nil, err
+		}
+		params.Set("lever_rate", leverRate)
 	}
 
 	data, err := f.DoAuthRequest(http.MethodPost,
@@ -65,7 +88,7 @@ func (f *USDTSwapPrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side
 		return nil, data, err
 	}
 
-	logger.Debugf("[create order] response data=%s", string(data))
+	f.exOpts.Logger.Debug("create order", "body", LogBody(data))
 
 	ord, err := f.unmarshalerOpts.CreateOrderResponseUnmarshaler(data)
 	if err != nil {
@@ -92,7 +115,7 @@ func (f *USDTSwapPrvApi) GetOrderInfo(pair CurrencyPair, id string, opts ...Opti
 		return nil, data, err
 	}
 
-	logger.Debugf("[GetOrderInfo] %s", string(data))
+	f.exOpts.Logger.Debug("get order info", "body", LogBody(data))
 	if data == nil || len(data) == 0 ||
 		bytes.Compare(data, []byte{110, 117, 108, 108}) == 0 {
 		return nil, data, nil
@@ -115,7 +138,7 @@ func (f *USDTSwapPrvApi) GetPendingOrders(pair CurrencyPair, opt ...OptionParame
 	if err != nil {
 		return nil, data, err
 	}
-	logger.Debugf("[GetPendingOrders] %s", string(data))
+	f.exOpts.Logger.Debug("get pending orders", "body", LogBody(data))
 	orders, err := f.unmarshalerOpts.GetPendingOrdersResponseUnmarshaler(data)
 	return orders, data, err
 }
@@ -133,11 +156,36 @@ func (f *USDTSwapPrvApi) GetHistoryOrders(pair CurrencyPair, opts ...OptionParam
 	if err != nil {
 		return nil, data, err
 	}
-	logger.Debugf("[GetHistoryOrders] %s", string(data))
+	f.exOpts.Logger.Debug("get history orders", "body", LogBody(data))
 	orders, err := f.unmarshalerOpts.GetHistoryOrdersResponseUnmarshaler(data)
 	return orders, data, err
 }
 
+func (f *USDTSwapPrvApi) GetFills(pair CurrencyPair, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("contract", pair.Symbol)
+	params.Set("trade_type", "0")
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetFillsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+	f.exOpts.Logger.Debug("get fills", "body", LogBody(data))
+
+	trades, err := f.unmarshalerOpts.GetFillsResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
 func (f *USDTSwapPrvApi) CancelOrder(pair CurrencyPair, id string, opt ...OptionParameter) ([]byte, error) {
 	params := url.Values{}
 	params.Set("order_id", id)
@@ -157,9 +205,151 @@ func (f *USDTSwapPrvApi) CancelOrder(pair CurrencyPair, id string, opt ...Option
 	return data, f.unmarshalerOpts.CancelOrderResponseUnmarshaler(data)
 }
 
-func (f *USDTSwapPrvApi) CancelOrders(pair *CurrencyPair, id []string, opt ...OptionParameter) error {
-	//TODO implement me
-	panic("implement me")
+// CreateOrders 批量下单,单次最多10个订单,opts会作用于每一个订单
+func (f *USDTSwapPrvApi) CreateOrders(orders []Order, opts ...OptionParameter) ([]BatchOrderResult, []byte, error) {
+	posMode, err := f.positionMode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ordersData := make([]map[string]string, 0, len(orders))
+	for _, ord := range orders {
+		direction, offset, reduceOnly, err := AdaptSideToDirectionAndOffset(ord.Side, posMode)
+		if err != nil {
+			return nil, nil, err
+		}
+		p := map[string]string{
+			"contract_code":    ord.Pair.Symbol,
+			"price":            FloatToString(ord.Price, ord.Pair.PricePrecision),
+			"volume":           FloatToString(ord.Qty, ord.Pair.QtyPrecision),
+			"order_price_type": string(ord.OrderTy),
+			"direction":        direction,
+			"offset":           offset,
+		}
+		if reduceOnly {
+			p["reduce_only"] = "1"
+		}
+		if ord.CId != "" {
+			p["client_order_id"] = ord.CId
+		}
+		for _, opt := range opts {
+			p[opt.Key] = opt.Value
+		}
+		if p["lever_rate"] == "" {
+			leverRate, err := f.getLeverRate(ord.Pair)
+			if err != nil {
+				return nil, nil, err
+			}
+			p["lever_rate"] = leverRate
+		}
+		ordersData = append(ordersData, p)
+	}
+
+	reqBody, err := json.Marshal(map[string]interface{}{"orders_data": ordersData})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := f.DoAuthRawRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.NewBatchOrdersUri), string(reqBody), nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	f.exOpts.Logger.Debug("create orders", "body", LogBody(data))
+
+	results, err := f.unmarshalerOpts.CreateOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if len(results) != len(orders) {
+		return nil, data, errors.New("the number of batch order results does not match the request")
+	}
+
+	for i := range results {
+		ord := orders[i]
+		ord.Id = results[i].Order.Id
+		ord.Status = OrderStatus_Pending
+		if results[i].Order.CId != "" {
+			ord.CId = results[i].Order.CId
+		}
+		results[i].Order = ord
+	}
+
+	return results, data, nil
+}
+
+// CancelOrders 批量撤单,单次最多10个订单
+func (f *USDTSwapPrvApi) CancelOrders(pair CurrencyPair, ids []string, opts ...OptionParameter) ([]BatchOrderResult, []byte, error) {
+	params := url.Values{}
+	params.Set("order_id", strings.Join(ids, ","))
+	params.Set("contract_code", pair.Symbol)
+
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.CancelBatchOrdersUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	cancelResults, err := f.unmarshalerOpts.CancelOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	resultM := make(map[string]BatchOrderResult, len(cancelResults))
+	for _, r := range cancelResults {
+		resultM[r.Order.Id] = r
+	}
+
+	results := make([]BatchOrderResult, 0, len(ids))
+	for _, id := range ids {
+		r, ok := resultM[id]
+		if !ok {
+			r.Err = errors.New("not found cancel result")
+		}
+		r.Order.Id = id
+		r.Order.Pair = pair
+		if r.Err == nil {
+			r.Order.Status = OrderStatus_Canceled
+		}
+		results = append(results, r)
+	}
+
+	return results, data, nil
+}
+
+func (f *USDTSwapPrvApi) CancelAllOrders(pair CurrencyPair, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.CancelAllOrdersUri), &params, nil)
+	if err != nil {
+		return data, err
+	}
+
+	results, err := f.unmarshalerOpts.CancelOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return data, err
+	}
+
+	var errMsgs []string
+	for _, r := range results {
+		if r.Err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("%s: %s", r.Order.Id, r.Err.Error()))
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return data, errors.New(strings.Join(errMsgs, "; "))
+	}
+
+	return data, nil
 }
 
 func (f *USDTSwapPrvApi) GetFuturesAccount(coin string) (acc map[string]FuturesAccount, responseBody []byte, err error) {
@@ -173,25 +363,62 @@ func (f *USDTSwapPrvApi) GetPositions(pair CurrencyPair, opts ...OptionParameter
 }
 
 func (f *USDTSwapPrvApi) DoAuthRequest(method, reqUrl string, params *url.Values, header map[string]string) ([]byte, error) {
-	///////////////////// 参数签名 ////////////////////////
-	signParams := common.DoSignParam(method, reqUrl, f.apiOpts)
+	reqBody, _ := ValuesToJson(*params)
+	return f.doAuthRawRequest(params, method, reqUrl, string(reqBody), header)
+}
+
+// DoAuthRawRequest 直接发送已经序列化好的请求体,用于批量下单等请求体包含嵌套数组的场景
+func (f *USDTSwapPrvApi) DoAuthRawRequest(method, reqUrl string, reqBody string, header map[string]string) ([]byte, error) {
+	return f.doAuthRawRequest(nil, method, reqUrl, reqBody, header)
+}
+
+// doAuthRawRequest params仅用于设置trace span的属性
+func (f *USDTSwapPrvApi) doAuthRawRequest(params *url.Values, method, reqUrl string, reqBody string, header map[string]string) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(f.GetName(), metrics.Layer_Auth, method, reqUrl, start, err)
+		LogRequest(f.exOpts.Logger, f.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(f.exOpts.Context, f.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	if err = f.checkEnv(); err != nil {
+		return nil, err
+	}
 
 	if header == nil {
 		header = make(map[string]string, 1)
 	}
 	header["Content-Type"] = "application/json"
 
-	reqBody, _ := ValuesToJson(*params)
-	logger.Debugf("request body: %s", string(reqBody))
-
-	respBodyData, err := Cli.DoRequest(method, reqUrl+"?"+signParams.Encode(), string(reqBody), header)
+	f.exOpts.Logger.Debug("request", "exchange", f.GetName(), "url", reqUrl, "body", reqBody)
+
+	//签名包含域名,切换域名时需要重新签名
+	respBodyData, err := f.uriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		///////////////////// 参数签名 ////////////////////////
+		signSpan := tracing.StartStep(ctx, "sign")
+		signParams, err := common.DoSignParam(method, reqUrl, f.apiOpts)
+		tracing.End(signSpan, err)
+		if err != nil {
+			return nil, err
+		}
+
+		httpSpan := tracing.StartStep(ctx, "http")
+		data, err := DoRequestContext(ctx, Cli, method, reqUrl+"?"+signParams.Encode(), reqBody, header)
+		tracing.End(httpSpan, err)
+		return data, err
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
 	var baseResp TradeBaseResponse
+	unmarshalSpan := tracing.StartStep(ctx, "unmarshal")
 	err = f.unmarshalerOpts.ResponseUnmarshaler(respBodyData, &baseResp)
+	tracing.End(unmarshalSpan, err)
 	if err != nil {
 		return nil, err
 	}
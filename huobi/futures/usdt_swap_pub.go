@@ -5,34 +5,57 @@ import (
 	"errors"
 	"fmt"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
-	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/tracing"
 	. "github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
+	"sort"
+	"time"
 )
 
 func (f *USDTSwap) GetName() string {
 	return "hbdm.com"
 }
 
-func (f *USDTSwap) DoNoAuthRequest(method, reqUrl string, params *url.Values) ([]byte, error) {
+func (f *USDTSwap) DoNoAuthRequest(method, reqUrl string, params *url.Values) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(f.GetName(), metrics.Layer_NoAuth, method, reqUrl, start, err)
+		LogRequest(f.exOpts.Logger, f.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(f.exOpts.Context, f.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	if err = f.checkEnv(); err != nil {
+		return nil, err
+	}
+
 	if method == http.MethodGet {
 		reqUrl += "?" + params.Encode()
 	}
 
-	respBodyData, err := Cli.DoRequest(method, reqUrl, "", map[string]string{
-		"Content-Type": "application/json",
+	httpSpan := tracing.StartStep(ctx, "http")
+	respBodyData, err := f.uriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		return DoRequestContext(ctx, Cli, method, reqUrl, "", map[string]string{
+			"Content-Type": "application/json",
+		})
 	})
+	tracing.End(httpSpan, err)
 
 	if err != nil {
 		return respBodyData, err
 	}
 
 	var baseResp BaseResponse
+	unmarshalSpan := tracing.StartStep(ctx, "unmarshal")
 	err = json.Unmarshal(respBodyData, &baseResp)
+	tracing.End(unmarshalSpan, err)
 	if err != nil {
-		logger.Errorf("[DoNoAuthRequest] err=%s", err.Error())
+		f.exOpts.Logger.Error("unmarshal response failed", "exchange", f.GetName(), "url", reqUrl, "error", err)
 		return nil, err
 	}
 
@@ -43,6 +66,20 @@ func (f *USDTSwap) DoNoAuthRequest(method, reqUrl string, params *url.Values) ([
 	return respBodyData, nil
 }
 
+// GetServerTime 服务器时间(毫秒)
+func (f *USDTSwap) GetServerTime(opts ...OptionParameter) (int64, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetServerTimeUri), &params)
+	if err != nil {
+		return 0, data, err
+	}
+
+	ts, err := f.unmarshalerOpts.GetServerTimeResponseUnmarshaler(data)
+	return ts, data, err
+}
+
 func (f *USDTSwap) GetDepth(pair CurrencyPair, limit int, opt ...OptionParameter) (*Depth, []byte, error) {
 	//TODO implement me
 	panic("implement me")
@@ -84,7 +121,7 @@ func (f *USDTSwap) GetKline(pair CurrencyPair, period KlinePeriod, opts ...Optio
 	if err != nil {
 		return nil, data, err
 	}
-	logger.Debugf("[GetKline] data=%s", string(data))
+	f.exOpts.Logger.Debug("get kline", "body", LogBody(data))
 
 	klines, err := f.unmarshalerOpts.KlineUnmarshaler(data)
 	if err != nil {
@@ -97,3 +134,118 @@ func (f *USDTSwap) GetKline(pair CurrencyPair, period KlinePeriod, opts ...Optio
 
 	return klines, data, err
 }
+
+func (f *USDTSwap) GetTrades(pair CurrencyPair, limit int, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	params.Set("size", fmt.Sprint(limit))
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetTradesUri), &params)
+	if err != nil {
+		return nil, data, err
+	}
+
+	trades, err := f.unmarshalerOpts.GetTradesResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
+func (f *USDTSwap) GetFundingRate(pair CurrencyPair, opts ...OptionParameter) (*FundingRate, []byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetFundingRateUri), &params)
+	if err != nil {
+		return nil, data, err
+	}
+
+	rate, err := f.unmarshalerOpts.GetFundingRateResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	rate.Pair = pair
+
+	return rate, data, nil
+}
+
+// GetFundingRateHistory limit最大为50,可以通过opt传入page_index翻页
+func (f *USDTSwap) GetFundingRateHistory(pair CurrencyPair, limit int, opts ...OptionParameter) ([]FundingRate, []byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	params.Set("page_size", fmt.Sprint(limit))
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetFundingRateHistoryUri), &params)
+	if err != nil {
+		return nil, data, err
+	}
+
+	rates, err := f.unmarshalerOpts.GetFundingRateHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	//统一为按时间升序
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].FundingTime < rates[j].FundingTime
+	})
+
+	for i := range rates {
+		rates[i].Pair = pair
+	}
+
+	return rates, data, nil
+}
+
+// GetMarkPrice 交易所没有单独的标记价格接口,使用1min标记价格K线的最新收盘价
+func (f *USDTSwap) GetMarkPrice(pair CurrencyPair, opts ...OptionParameter) (*MarkPrice, []byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	params.Set("period", "1min")
+	params.Set("size", "1")
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetMarkPriceUri), &params)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price, err := f.unmarshalerOpts.GetMarkPriceResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price.Pair = pair
+
+	return price, data, nil
+}
+
+func (f *USDTSwap) GetIndexPrice(pair CurrencyPair, opts ...OptionParameter) (*IndexPrice, []byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetIndexPriceUri), &params)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price, err := f.unmarshalerOpts.GetIndexPriceResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	price.Pair = pair
+
+	return price, data, nil
+}
@@ -0,0 +1,90 @@
+package futures
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// GetOpenInterestHistory 持仓量以币计价(amount_type=2)
+//
+//	period 支持Kline_1h,Kline_4h,Kline_1day
+//	limit <=0 时使用交易所默认条数,最大200
+func (f *USDTSwap) GetOpenInterestHistory(pair CurrencyPair, period KlinePeriod, limit int, opts ...OptionParameter) ([]OpenInterest, []byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	params.Set("period", AdaptKlinePeriod(period))
+	if limit > 200 {
+		limit = 200
+	}
+	if limit > 0 {
+		params.Set("size", fmt.Sprint(limit))
+	}
+	params.Set("amount_type", "2")
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetOpenInterestHistoryUri), &params)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ois, err := f.unmarshalerOpts.GetOpenInterestHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	//统一为按时间升序,超过limit时保留最近的limit条
+	sort.Slice(ois, func(i, j int) bool {
+		return ois[i].Timestamp < ois[j].Timestamp
+	})
+	if limit > 0 && len(ois) > limit {
+		ois = ois[len(ois)-limit:]
+	}
+
+	for i := range ois {
+		ois[i].Pair = pair
+	}
+
+	return ois, data, nil
+}
+
+// GetLongShortRatio 精英账户多空持仓人数比
+//
+//	period 支持Kline_5min,Kline_15min,Kline_30min,Kline_1h,Kline_4h,Kline_1day
+func (f *USDTSwap) GetLongShortRatio(pair CurrencyPair, period KlinePeriod, limit int, opts ...OptionParameter) ([]LongShortRatio, []byte, error) {
+	params := url.Values{}
+	params.Set("contract_code", pair.Symbol)
+	params.Set("period", AdaptKlinePeriod(period))
+	MergeOptionParams(&params, opts...)
+
+	data, err := f.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", f.uriOpts.Endpoint, f.uriOpts.GetLongShortRatioUri), &params)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ratios, err := f.unmarshalerOpts.GetLongShortRatioResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	sort.Slice(ratios, func(i, j int) bool {
+		return ratios[i].Timestamp < ratios[j].Timestamp
+	})
+	if limit > 0 && len(ratios) > limit {
+		ratios = ratios[len(ratios)-limit:]
+	}
+
+	for i := range ratios {
+		ratios[i].Pair = pair
+	}
+
+	return ratios, data, nil
+}
+
+func (f *USDTSwap) GetTakerVolume(pair CurrencyPair, period KlinePeriod, limit int, opts ...OptionParameter) ([]TakerVolume, []byte, error) {
+	return nil, nil, errors.New("huobi not support taker volume statistics")
+}
@@ -3,6 +3,7 @@ package huobi
 import (
 	"github.com/nntaoli-project/goex/v2/huobi/futures"
 	"github.com/nntaoli-project/goex/v2/huobi/spot"
+	"github.com/nntaoli-project/goex/v2/options"
 )
 
 type HuoBi struct {
@@ -10,9 +11,9 @@ type HuoBi struct {
 	Futures *futures.Futures
 }
 
-func New() *HuoBi {
+func New(opts ...options.ExchangeOption) *HuoBi {
 	return &HuoBi{
-		Spot:    spot.New(),
-		Futures: futures.New(),
+		Spot:    spot.New(opts...),
+		Futures: futures.New(opts...),
 	}
 }
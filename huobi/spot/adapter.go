@@ -0,0 +1,17 @@
+package spot
+
+import (
+	"errors"
+	. "github.com/nntaoli-project/goex/v2/model"
+)
+
+// adaptAccountType 划转接口只支持现货账户与U本位合约账户
+func adaptAccountType(ty AccountType) (string, error) {
+	switch ty {
+	case AccountType_Spot:
+		return "spot", nil
+	case AccountType_USDTFutures:
+		return "linear-swap", nil
+	}
+	return "", errors.New("huobi transfer only support AccountType_Spot and AccountType_USDTFutures")
+}
@@ -0,0 +1,178 @@
+package spot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/huobi/common"
+	"github.com/nntaoli-project/goex/v2/metrics"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/tracing"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type PrvBaseResponse struct {
+	Status  string          `json:"status"`
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type PrvApi struct {
+	*Spot
+	apiOpts options.ApiOptions
+}
+
+func NewPrvApi(apiOpts ...options.ApiOption) *PrvApi {
+	s := new(PrvApi)
+	for _, opt := range apiOpts {
+		opt(&s.apiOpts)
+	}
+	return s
+}
+
+// Transfer 现货账户与U本位合约账户之间划转
+func (s *PrvApi) Transfer(coin string, amount float64, from, to AccountType, opts ...OptionParameter) (string, []byte, error) {
+	fromSym, err := adaptAccountType(from)
+	if err != nil {
+		return "", nil, err
+	}
+	toSym, err := adaptAccountType(to)
+	if err != nil {
+		return "", nil, err
+	}
+
+	params := url.Values{}
+	params.Set("from", fromSym)
+	params.Set("to", toSym)
+	params.Set("currency", coin)
+	params.Set("amount", FloatToString(amount, 8))
+	params.Set("margin-account", "USDT")
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodPost, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.TransferUri), &params, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	id, err := s.unmarshalerOpts.TransferResponseUnmarshaler(data)
+	return id, data, err
+}
+
+// GetTransferHistory 不支持:huobi的现货账户流水(/v2/account/ledger)需要account-id且没有区分划转方向,
+// 无法可靠地还原from/to,直接返回错误
+func (s *PrvApi) GetTransferHistory(coin string, from, to AccountType, opts ...OptionParameter) ([]TransferRecord, []byte, error) {
+	return nil, nil, errors.New("huobi not support transfer history query")
+}
+
+func (s *PrvApi) GetTradeFee(pair CurrencyPair, opts ...OptionParameter) (*TradeFee, []byte, error) {
+	params := url.Values{}
+	params.Set("symbols", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.GetTradeFeeUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	fee, err := s.unmarshalerOpts.GetTradeFeeResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+	fee.Pair = pair
+
+	return fee, data, nil
+}
+
+// GetFills 查询最近48小时的成交明细,可以通过opt传入start-time、end-time、from、size等参数
+func (s *PrvApi) GetFills(pair CurrencyPair, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.GetFillsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	trades, err := s.unmarshalerOpts.GetFillsResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
+// DoAuthRequest GET请求的参数参与签名放在query中,其它请求的参数以json格式放在请求体中
+func (s *PrvApi) DoAuthRequest(method, reqUrl string, params *url.Values, header map[string]string) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(s.GetName(), metrics.Layer_Auth, method, reqUrl, start, err)
+		LogRequest(s.exOpts.Logger, s.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(s.exOpts.Context, s.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	var reqBody string
+	if method != http.MethodGet {
+		body, _ := ValuesToJson(*params)
+		reqBody = string(body)
+	}
+
+	if header == nil {
+		header = make(map[string]string, 1)
+	}
+	header["Content-Type"] = "application/json"
+
+	//签名包含域名,切换域名时需要重新签名
+	respBodyData, err := s.uriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		var (
+			signParams *url.Values
+			err        error
+		)
+		signSpan := tracing.StartStep(ctx, "sign")
+		if method == http.MethodGet {
+			signParams, err = common.DoSignQueryParam(method, reqUrl, *params, s.apiOpts)
+		} else {
+			signParams, err = common.DoSignParam(method, reqUrl, s.apiOpts)
+		}
+		tracing.End(signSpan, err)
+		if err != nil {
+			return nil, err
+		}
+
+		httpSpan := tracing.StartStep(ctx, "http")
+		data, err := DoRequestContext(ctx, Cli, method, reqUrl+"?"+signParams.Encode(), reqBody, header)
+		tracing.End(httpSpan, err)
+		return data, err
+	})
+	s.exOpts.Logger.Debug("response", "exchange", s.GetName(), "url", reqUrl, "body", LogBody(respBodyData))
+	if err != nil {
+		return respBodyData, fmt.Errorf("%w%s", err, errors.New(string(respBodyData)))
+	}
+
+	var resp PrvBaseResponse
+	unmarshalSpan := tracing.StartStep(ctx, "unmarshal")
+	err = s.unmarshalerOpts.ResponseUnmarshaler(respBodyData, &resp)
+	tracing.End(unmarshalSpan, err)
+	if err != nil {
+		return respBodyData, err
+	}
+
+	if resp.Status == "ok" || resp.Code == 200 {
+		return resp.Data, nil
+	}
+
+	return respBodyData, errors.New(string(respBodyData))
+}
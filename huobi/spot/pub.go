@@ -4,9 +4,13 @@ import (
 	"errors"
 	"fmt"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/tracing"
+	. "github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 func (s *Spot) GetName() string {
@@ -19,8 +23,12 @@ func (s *Spot) GetDepth(pair CurrencyPair, limit int, opt ...OptionParameter) (*
 }
 
 func (s *Spot) GetTicker(pair CurrencyPair, opt ...OptionParameter) (*Ticker, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opt...)
+
 	data, err := s.DoNoAuthRequest(http.MethodGet,
-		fmt.Sprintf("%s%s?symbol=%s", s.uriOpts.Endpoint, s.uriOpts.TickerUri, pair.Symbol), nil, nil)
+		fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.TickerUri), &params, nil)
 	if err != nil {
 		return nil, data, fmt.Errorf("%w%s", err, errors.New(string(data)))
 	}
@@ -40,28 +48,81 @@ func (s *Spot) GetKline(pair CurrencyPair, period KlinePeriod, opt ...OptionPara
 	panic("implement me")
 }
 
+func (s *Spot) GetTrades(pair CurrencyPair, limit int, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("size", fmt.Sprint(limit))
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.GetTradesUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	trades, err := s.unmarshalerOpts.GetTradesResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
 func (s *Spot) GetExchangeInfo() (map[string]CurrencyPair, []byte, error) {
 	panic("not implement")
 }
 
-func (s *Spot) DoNoAuthRequest(method, reqUrl string, params *url.Values, headers map[string]string) ([]byte, error) {
+// GetServerTime 服务器时间(毫秒)
+func (s *Spot) GetServerTime(opts ...OptionParameter) (int64, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.GetServerTimeUri), &params, nil)
+	if err != nil {
+		return 0, data, err
+	}
+
+	ts, err := s.unmarshalerOpts.GetServerTimeResponseUnmarshaler(data)
+	return ts, data, err
+}
+
+func (s *Spot) DoNoAuthRequest(method, reqUrl string, params *url.Values, headers map[string]string) (_ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(s.GetName(), metrics.Layer_NoAuth, method, reqUrl, start, err)
+		LogRequest(s.exOpts.Logger, s.GetName(), method, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(s.exOpts.Context, s.GetName(), method, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	if method == http.MethodGet && params != nil {
 		reqUrl += "?" + params.Encode()
 	}
 
-	responseData, err := Cli.DoRequest(method, reqUrl, "", headers)
+	httpSpan := tracing.StartStep(ctx, "http")
+	responseData, err := s.uriOpts.EndpointPool.Do(method, reqUrl, func(reqUrl string) ([]byte, error) {
+		return DoRequestContext(ctx, Cli, method, reqUrl, "", headers)
+	})
+	tracing.End(httpSpan, err)
 	if err != nil {
 		return responseData, fmt.Errorf("%w%s", err, errors.New(string(responseData)))
 	}
 
 	var resp BaseResponse
 
+	unmarshalSpan := tracing.StartStep(ctx, "unmarshal")
 	err = s.unmarshalerOpts.ResponseUnmarshaler(responseData, &resp)
+	tracing.End(unmarshalSpan, err)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.Status != "ok" {
+	if resp.Status != "ok" && resp.Code != 200 {
 		return nil, errors.New(string(responseData))
 	}
 
@@ -1,6 +1,7 @@
 package spot
 
 import (
+	"github.com/nntaoli-project/goex/v2/httpcli"
 	. "github.com/nntaoli-project/goex/v2/model"
 	. "github.com/nntaoli-project/goex/v2/options"
 )
@@ -11,6 +12,7 @@ var (
 
 type BaseResponse struct {
 	Status  string `json:"status"`
+	Code    int    `json:"code"`
 	ErrCode int    `json:"err_code"`
 	ErrMsg  string `json:"err_msg"`
 }
@@ -18,28 +20,65 @@ type BaseResponse struct {
 type Spot struct {
 	uriOpts         UriOptions
 	unmarshalerOpts UnmarshalerOptions
+	exOpts          ExchangeOptions
 }
 
-func New() *Spot {
+func New(opts ...ExchangeOption) *Spot {
 	s := &Spot{
+		exOpts: NewExchangeOptions(opts...),
 		uriOpts: UriOptions{
-			Endpoint:            "https://api.huobi.pro",
-			TickerUri:           "/market/detail/merged",
-			DepthUri:            "",
-			KlineUri:            "",
-			GetOrderUri:         "",
-			GetPendingOrdersUri: "",
-			GetHistoryOrdersUri: "",
-			CancelOrderUri:      "",
-			NewOrderUri:         "",
+			Endpoint:                  "https://api.huobi.pro",
+			TickerUri:                 "/market/detail/merged",
+			GetServerTimeUri:          "/v1/common/timestamp",
+			DepthUri:                  "",
+			KlineUri:                  "",
+			GetTradesUri:              "/market/history/trade",
+			GetOrderUri:               "",
+			GetPendingOrdersUri:       "",
+			GetHistoryOrdersUri:       "",
+			CancelOrderUri:            "",
+			NewOrderUri:               "",
+			TransferUri:               "/v2/account/transfer",
+			GetCoinNetworksUri:        "/v2/reference/currencies",
+			GetDepositAddressUri:      "/v2/account/deposit/address",
+			GetDepositHistoryUri:      "/v1/query/deposit-withdraw",
+			GetWithdrawHistoryUri:     "/v1/query/deposit-withdraw",
+			WithdrawUri:               "/v1/dw/withdraw/api/create",
+			CancelWithdrawUri:         "/v1/dw/withdraw-virtual/%s/cancel",
+			GetSubAccountsUri:         "/v2/sub-user/user-list",
+			GetSubAccountBalanceUri:   "/v1/account/accounts/%s",
+			SubAccountTransferUri:     "/v1/subuser/transfer",
+			CreateSubAccountApiKeyUri: "/v2/sub-user/api-key-generation",
+			GetTradeFeeUri:            "/v2/reference/transact-fee-rate",
+			GetFillsUri:               "/v1/order/matchresults",
 		},
 		unmarshalerOpts: UnmarshalerOptions{
-			ResponseUnmarshaler: UnmarshalResponse,
-			TickerUnmarshaler:   UnmarshalTicker,
-			DepthUnmarshaler:    UnmarshalDepth,
+			ResponseUnmarshaler:                       UnmarshalResponse,
+			GetServerTimeResponseUnmarshaler:          UnmarshalGetServerTimeResponse,
+			TickerUnmarshaler:                         UnmarshalTicker,
+			DepthUnmarshaler:                          UnmarshalDepth,
+			GetTradesResponseUnmarshaler:              UnmarshalGetTradesResponse,
+			TransferResponseUnmarshaler:               UnmarshalTransferResponse,
+			GetCoinNetworksResponseUnmarshaler:        UnmarshalGetCoinNetworksResponse,
+			GetDepositAddressResponseUnmarshaler:      UnmarshalGetDepositAddressResponse,
+			GetDepositHistoryResponseUnmarshaler:      UnmarshalGetWalletRecordsResponse,
+			GetWithdrawHistoryResponseUnmarshaler:     UnmarshalGetWalletRecordsResponse,
+			WithdrawResponseUnmarshaler:               UnmarshalWithdrawResponse,
+			GetAccountResponseUnmarshaler:             UnmarshalGetAccountResponse,
+			GetSubAccountsResponseUnmarshaler:         UnmarshalGetSubAccountsResponse,
+			CreateSubAccountApiKeyResponseUnmarshaler: UnmarshalCreateSubAccountApiKeyResponse,
+			GetTradeFeeResponseUnmarshaler:            UnmarshalGetTradeFeeResponse,
+			GetFillsResponseUnmarshaler:               UnmarshalGetFillsResponse,
 		},
 	}
 
+	if s.exOpts.IsSandbox() {
+		s.uriOpts.Endpoint = "https://api.testnet.huobi.pro"
+	} else {
+		s.uriOpts.EndpointPool = httpcli.NewEndpointPool(s.exOpts.Logger, s.uriOpts.GetServerTimeUri, s.uriOpts.Endpoint, "https://api-aws.huobi.pro")
+		s.uriOpts.EndpointPool.Start(s.exOpts.EndpointProbeInterval)
+	}
+
 	return s
 }
 
@@ -56,3 +95,9 @@ func (s *Spot) WithUriOptions(opts ...UriOption) *Spot {
 	}
 	return s
 }
+
+func (s *Spot) NewPrvApi(apiOpts ...ApiOption) *PrvApi {
+	prv := NewPrvApi(apiOpts...)
+	prv.Spot = s
+	return prv
+}
@@ -0,0 +1,99 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetSubAccounts 子账户列表,SubAccount.Name为子用户UID
+func (s *PrvApi) GetSubAccounts(opts ...OptionParameter) ([]SubAccount, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.GetSubAccountsUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	subAccounts, err := s.unmarshalerOpts.GetSubAccountsResponseUnmarshaler(data)
+	return subAccounts, data, err
+}
+
+// GetSubAccountBalance 获取子账户余额,subAccount为子用户UID
+func (s *PrvApi) GetSubAccountBalance(subAccount string, opts ...OptionParameter) (map[string]Account, []byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	reqUrl := fmt.Sprintf("%s%s", s.uriOpts.Endpoint, fmt.Sprintf(s.uriOpts.GetSubAccountBalanceUri, subAccount))
+	data, err := s.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	balances, err := s.unmarshalerOpts.GetAccountResponseUnmarshaler(data)
+	return balances, data, err
+}
+
+// SubAccountTransfer 母子账户之间划转,huobi不支持子账户之间直接划转
+func (s *PrvApi) SubAccountTransfer(coin string, amount float64, fromSub, toSub string, opts ...OptionParameter) (string, []byte, error) {
+	params := url.Values{}
+	switch {
+	case fromSub == "" && toSub != "":
+		params.Set("sub-uid", toSub)
+		params.Set("type", "master-transfer-out")
+	case fromSub != "" && toSub == "":
+		params.Set("sub-uid", fromSub)
+		params.Set("type", "master-transfer-in")
+	default:
+		return "", nil, errors.New("huobi only support transfer between master and sub account")
+	}
+	params.Set("currency", strings.ToLower(coin))
+	params.Set("amount", FloatToString(amount, 8))
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodPost, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.SubAccountTransferUri), &params, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	id, err := s.unmarshalerOpts.TransferResponseUnmarshaler(data)
+	return id, data, err
+}
+
+// CreateSubAccountApiKey 创建子账户API Key,必须通过opts传入母账户的otpToken参数
+func (s *PrvApi) CreateSubAccountApiKey(subAccount, label string, canTrade bool, ips []string, opts ...OptionParameter) (*SubAccountApiKey, []byte, error) {
+	params := url.Values{}
+	params.Set("subUid", subAccount)
+	params.Set("note", label)
+	if canTrade {
+		params.Set("permission", "readOnly,trade")
+	} else {
+		params.Set("permission", "readOnly")
+	}
+	if len(ips) > 0 {
+		params.Set("ipAddresses", strings.Join(ips, ","))
+	}
+	MergeOptionParams(&params, opts...)
+
+	if params.Get("otpToken") == "" {
+		return nil, nil, errors.New("huobi create sub account api key need otpToken parameter")
+	}
+
+	data, err := s.DoAuthRequest(http.MethodPost, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.CreateSubAccountApiKeyUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	apiKey, err := s.unmarshalerOpts.CreateSubAccountApiKeyResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+	apiKey.SubAccount = subAccount
+
+	return apiKey, data, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/buger/jsonparser"
 	. "github.com/nntaoli-project/goex/v2/model"
 	"github.com/spf13/cast"
+	"strings"
 )
 
 func UnmarshalResponse(data []byte, i interface{}) error {
@@ -61,3 +62,292 @@ func UnmarshalTicker(data []byte) (*Ticker, error) {
 	tk.Percent = (tk.Last - open) / open * 100
 	return tk, nil
 }
+
+func UnmarshalGetTradesResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		_, err = jsonparser.ArrayEach(value, func(item []byte, dataType jsonparser.ValueType, offset int, err error) {
+			var trade Trade
+			_ = jsonparser.ObjectEach(item, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+				valStr := string(val)
+				switch string(key) {
+				case "trade-id":
+					trade.Tid = valStr
+				case "price":
+					trade.Price = cast.ToFloat64(valStr)
+				case "amount":
+					trade.Qty = cast.ToFloat64(valStr)
+				case "direction":
+					if valStr == "sell" {
+						trade.Side = Spot_Sell
+					} else {
+						trade.Side = Spot_Buy
+					}
+				case "ts":
+					trade.Timestamp = cast.ToInt64(valStr)
+				}
+				return nil
+			})
+			trades = append(trades, trade)
+		}, "data")
+	}, "data")
+
+	return trades, err
+}
+
+// UnmarshalTransferResponse 解析划转接口返回的data(划转ID)
+func UnmarshalGetServerTimeResponse(data []byte) (int64, error) {
+	return jsonparser.GetInt(data, "data")
+}
+
+func UnmarshalTransferResponse(data []byte) (string, error) {
+	return string(data), nil
+}
+
+func UnmarshalGetCoinNetworksResponse(data []byte) ([]CoinNetwork, error) {
+	var networks []CoinNetwork
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		coin, _ := jsonparser.GetString(value, "currency")
+		_, err = jsonparser.ArrayEach(value, func(item []byte, dataType jsonparser.ValueType, offset int, err error) {
+			network := CoinNetwork{Coin: strings.ToUpper(coin)}
+			_ = jsonparser.ObjectEach(item, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+				valStr := string(val)
+				switch string(key) {
+				case "chain":
+					network.Network = valStr
+				case "depositStatus":
+					network.CanDeposit = valStr == "allowed"
+				case "withdrawStatus":
+					network.CanWithdraw = valStr == "allowed"
+				case "transactFeeWithdraw":
+					network.WithdrawFee = cast.ToFloat64(valStr)
+				case "minWithdrawAmt":
+					network.WithdrawMin = cast.ToFloat64(valStr)
+				case "maxWithdrawAmt":
+					network.WithdrawMax = cast.ToFloat64(valStr)
+				}
+				return nil
+			})
+			networks = append(networks, network)
+		}, "chains")
+	}, "data")
+
+	return networks, err
+}
+
+func UnmarshalGetDepositAddressResponse(data []byte) ([]DepositAddress, error) {
+	var addresses []DepositAddress
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var addr DepositAddress
+		_ = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "currency":
+				addr.Coin = strings.ToUpper(valStr)
+			case "chain":
+				addr.Network = valStr
+			case "address":
+				addr.Address = valStr
+			case "addressTag":
+				addr.Tag = valStr
+			}
+			return nil
+		})
+		addresses = append(addresses, addr)
+	})
+
+	return addresses, err
+}
+
+// UnmarshalGetWalletRecordsResponse 充值和提币记录使用同一个接口,通过type参数区分
+func UnmarshalGetWalletRecordsResponse(data []byte) ([]WalletRecord, error) {
+	var records []WalletRecord
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var record WalletRecord
+		_ = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				record.Id = valStr
+			case "currency":
+				record.Coin = strings.ToUpper(valStr)
+			case "chain":
+				record.Network = valStr
+			case "address":
+				record.Address = valStr
+			case "address-tag":
+				record.Tag = valStr
+			case "amount":
+				record.Amount = cast.ToFloat64(valStr)
+			case "fee":
+				record.Fee = cast.ToFloat64(valStr)
+			case "tx-hash":
+				record.TxId = valStr
+			case "state":
+				record.Status = valStr
+			case "created-at":
+				record.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		records = append(records, record)
+	})
+
+	return records, err
+}
+
+func UnmarshalWithdrawResponse(data []byte) (string, error) {
+	return string(data), nil
+}
+
+func UnmarshalGetSubAccountsResponse(data []byte) ([]SubAccount, error) {
+	var subAccounts []SubAccount
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var sub SubAccount
+		_ = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "uid":
+				sub.Name = valStr
+				sub.Uid = valStr
+			case "subUserName":
+				sub.Label = valStr
+			case "userState":
+				sub.Enabled = valStr == "normal"
+			}
+			return nil
+		})
+		subAccounts = append(subAccounts, sub)
+	})
+
+	return subAccounts, err
+}
+
+// UnmarshalGetAccountResponse 解析账户余额列表,list中同一币种分为trade和frozen两条
+func UnmarshalGetAccountResponse(data []byte) (map[string]Account, error) {
+	var accMap = make(map[string]Account, 4)
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		_, err = jsonparser.ArrayEach(value, func(item []byte, dataType jsonparser.ValueType, offset int, err error) {
+			currency, _ := jsonparser.GetString(item, "currency")
+			ty, _ := jsonparser.GetString(item, "type")
+			balance, _ := jsonparser.GetString(item, "balance")
+
+			coin := strings.ToUpper(currency)
+			acc := accMap[coin]
+			acc.Coin = coin
+			switch ty {
+			case "trade":
+				acc.AvailableBalance = cast.ToFloat64(balance)
+			case "frozen":
+				acc.FrozenBalance = cast.ToFloat64(balance)
+			}
+			acc.Balance = acc.AvailableBalance + acc.FrozenBalance
+			accMap[coin] = acc
+		}, "list")
+	})
+
+	return accMap, err
+}
+
+func UnmarshalCreateSubAccountApiKeyResponse(data []byte) (*SubAccountApiKey, error) {
+	var apiKey SubAccountApiKey
+
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "note":
+			apiKey.Label = valStr
+		case "accessKey":
+			apiKey.Key = valStr
+		case "secretKey":
+			apiKey.Secret = valStr
+		case "permission":
+			apiKey.Perm = valStr
+		case "ipAddresses":
+			if valStr != "" {
+				apiKey.Ips = strings.Split(valStr, ",")
+			}
+		}
+		return nil
+	})
+
+	return &apiKey, err
+}
+
+// UnmarshalGetTradeFeeResponse 使用抵扣后的实际费率
+func UnmarshalGetTradeFeeResponse(data []byte) (*TradeFee, error) {
+	var fee TradeFee
+
+	err := jsonparser.ObjectEach(data[1:len(data)-1], func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "actualMakerRate":
+			fee.Maker = cast.ToFloat64(valStr)
+		case "actualTakerRate":
+			fee.Taker = cast.ToFloat64(valStr)
+		}
+		return nil
+	})
+
+	return &fee, err
+}
+
+// UnmarshalGetFillsResponse 使用点卡或者HT抵扣手续费时,Fee为抵扣的数量,FeeCcy为抵扣的币种
+func UnmarshalGetFillsResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			trade              Trade
+			deductCcy          string
+			fees, deductPoints float64
+		)
+		_ = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "trade-id":
+				trade.Tid = valStr
+			case "order-id":
+				trade.OrderId = valStr
+			case "price":
+				trade.Price = cast.ToFloat64(valStr)
+			case "filled-amount":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "filled-fees":
+				fees = cast.ToFloat64(valStr)
+			case "fee-currency":
+				trade.FeeCcy = valStr
+			case "filled-points":
+				deductPoints = cast.ToFloat64(valStr)
+			case "fee-deduct-currency":
+				deductCcy = valStr
+			case "role":
+				trade.IsMaker = valStr == "maker"
+			case "created-at":
+				trade.Timestamp = cast.ToInt64(valStr)
+			case "type":
+				//buy-limit, sell-market ...
+				if strings.HasPrefix(valStr, "sell") {
+					trade.Side = Spot_Sell
+				} else {
+					trade.Side = Spot_Buy
+				}
+			}
+			return nil
+		})
+
+		trade.Fee = fees
+		if deductCcy != "" {
+			trade.Fee, trade.FeeCcy = deductPoints, deductCcy
+		}
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
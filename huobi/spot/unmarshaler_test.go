@@ -0,0 +1,38 @@
+package spot
+
+import (
+	"testing"
+)
+
+func TestUnmarshalGetTradeFeeResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantMaker float64
+		wantTaker float64
+		wantErr   bool
+	}{
+		{
+			name:      "actual rate",
+			data:      `[{"symbol":"btcusdt","makerFeeRate":"0.002","takerFeeRate":"0.002","actualMakerRate":"0.0016","actualTakerRate":"0.0018"}]`,
+			wantMaker: 0.0016,
+			wantTaker: 0.0018,
+		},
+		{name: "empty", data: `[]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fee, err := UnmarshalGetTradeFeeResponse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if fee.Maker != tt.wantMaker || fee.Taker != tt.wantTaker {
+				t.Fatalf("fee = (%v, %v), want (%v, %v)", fee.Maker, fee.Taker, tt.wantMaker, tt.wantTaker)
+			}
+		})
+	}
+}
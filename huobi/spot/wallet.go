@@ -0,0 +1,127 @@
+package spot
+
+import (
+	"fmt"
+	"github.com/buger/jsonparser"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (s *PrvApi) GetCoinNetworks(coin string, opts ...OptionParameter) ([]CoinNetwork, []byte, error) {
+	params := url.Values{}
+	if coin != "" {
+		params.Set("currency", strings.ToLower(coin))
+	}
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.GetCoinNetworksUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	networks, err := s.unmarshalerOpts.GetCoinNetworksResponseUnmarshaler(data)
+	return networks, data, err
+}
+
+func (s *PrvApi) GetDepositAddress(coin, network string, opts ...OptionParameter) ([]DepositAddress, []byte, error) {
+	params := url.Values{}
+	params.Set("currency", strings.ToLower(coin))
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.GetDepositAddressUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	addresses, err := s.unmarshalerOpts.GetDepositAddressResponseUnmarshaler(data)
+	if err != nil || network == "" {
+		return addresses, data, err
+	}
+
+	filtered := make([]DepositAddress, 0, 1)
+	for _, addr := range addresses {
+		if addr.Network == network {
+			filtered = append(filtered, addr)
+		}
+	}
+
+	return filtered, data, nil
+}
+
+func (s *PrvApi) GetDepositHistory(coin string, opts ...OptionParameter) ([]WalletRecord, []byte, error) {
+	opts = append([]OptionParameter{{Key: "type", Value: "deposit"}}, opts...)
+	return s.getWalletRecords(s.uriOpts.GetDepositHistoryUri, s.unmarshalerOpts.GetDepositHistoryResponseUnmarshaler, coin, opts...)
+}
+
+func (s *PrvApi) GetWithdrawHistory(coin string, opts ...OptionParameter) ([]WalletRecord, []byte, error) {
+	opts = append([]OptionParameter{{Key: "type", Value: "withdraw"}}, opts...)
+	return s.getWalletRecords(s.uriOpts.GetWithdrawHistoryUri, s.unmarshalerOpts.GetWithdrawHistoryResponseUnmarshaler, coin, opts...)
+}
+
+func (s *PrvApi) getWalletRecords(uri string, unmarshaler func([]byte) ([]WalletRecord, error), coin string, opts ...OptionParameter) ([]WalletRecord, []byte, error) {
+	params := url.Values{}
+	if coin != "" {
+		params.Set("currency", strings.ToLower(coin))
+	}
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodGet, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, uri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	records, err := unmarshaler(data)
+	return records, data, err
+}
+
+// Withdraw req.Fee为0时由交易所按默认手续费扣除,必须通过options.WithWithdrawGuard设置安全检查
+func (s *PrvApi) Withdraw(req WithdrawRequest, opts ...OptionParameter) (string, []byte, error) {
+	return s.apiOpts.WithdrawGuard.Withdraw(req, func() (string, []byte, error) {
+		return s.withdraw(req, opts...)
+	})
+}
+
+func (s *PrvApi) withdraw(req WithdrawRequest, opts ...OptionParameter) (string, []byte, error) {
+	params := url.Values{}
+	params.Set("address", req.Address)
+	params.Set("currency", strings.ToLower(req.Coin))
+	params.Set("amount", FloatToString(req.Amount, 8))
+	params.Set("chain", req.Network)
+	if req.Fee > 0 {
+		params.Set("fee", FloatToString(req.Fee, 8))
+	}
+	if req.Tag != "" {
+		params.Set("addr-tag", req.Tag)
+	}
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodPost, fmt.Sprintf("%s%s", s.uriOpts.Endpoint, s.uriOpts.WithdrawUri), &params, nil)
+	if err != nil {
+		//status=error说明交易所没有受理
+		if status, _ := jsonparser.GetString(data, "status"); status == "error" {
+			err = options.WithdrawRejected(err)
+		}
+		return "", data, err
+	}
+
+	id, err := s.unmarshalerOpts.WithdrawResponseUnmarshaler(data)
+	return id, data, err
+}
+
+func (s *PrvApi) CancelWithdraw(id string, opts ...OptionParameter) ([]byte, error) {
+	params := url.Values{}
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", s.uriOpts.Endpoint, fmt.Sprintf(s.uriOpts.CancelWithdrawUri, id)), &params, nil)
+	if err != nil {
+		return data, err
+	}
+
+	s.apiOpts.WithdrawGuard.Refund(id)
+	return data, nil
+}
@@ -41,61 +41,61 @@ func SetLevel(level LogLevel) {
 }
 
 func Debug(args ...interface{}) {
-	std.Output(3, logger.DEBUG, "[DEBUG]", fmt.Sprint(args...))
+	std.Output(3, logger.DEBUG, "[DEBUG]", RedactString(fmt.Sprint(args...)))
 }
 
 func Debugf(format string, args ...interface{}) {
-	std.Output(3, logger.DEBUG, "[DEBUG]", fmt.Sprintf(format, args...))
+	std.Output(3, logger.DEBUG, "[DEBUG]", RedactString(fmt.Sprintf(format, args...)))
 }
 
 func Info(args ...interface{}) {
-	std.Output(3, logger.INFO, "[INFO ]", fmt.Sprint(args...))
+	std.Output(3, logger.INFO, "[INFO ]", RedactString(fmt.Sprint(args...)))
 }
 
 func Infof(format string, args ...interface{}) {
-	std.Output(3, logger.INFO, "[INFO ]", fmt.Sprintf(format, args...))
+	std.Output(3, logger.INFO, "[INFO ]", RedactString(fmt.Sprintf(format, args...)))
 }
 
 func Warn(args ...interface{}) {
-	std.Output(3, logger.WARN, "[WARN ]", fmt.Sprint(args...))
+	std.Output(3, logger.WARN, "[WARN ]", RedactString(fmt.Sprint(args...)))
 }
 
 func Warnf(format string, args ...interface{}) {
-	std.Output(3, logger.WARN, "[WARN ]", fmt.Sprintf(format, args...))
+	std.Output(3, logger.WARN, "[WARN ]", RedactString(fmt.Sprintf(format, args...)))
 }
 
 func Error(args ...interface{}) {
-	std.Output(3, logger.ERROR, "[ERROR]", fmt.Sprint(args...))
+	std.Output(3, logger.ERROR, "[ERROR]", RedactString(fmt.Sprint(args...)))
 }
 
 func Errorf(format string, args ...interface{}) {
-	std.Output(3, logger.ERROR, "[ERROR]", fmt.Sprintf(format, args...))
+	std.Output(3, logger.ERROR, "[ERROR]", RedactString(fmt.Sprintf(format, args...)))
 }
 
 func Fatal(args ...interface{}) {
 	if std.level <= FATAL {
-		std.Output(3, logger.FATAL, "[FATAL]", fmt.Sprint(args...))
+		std.Output(3, logger.FATAL, "[FATAL]", RedactString(fmt.Sprint(args...)))
 		os.Exit(1)
 	}
 }
 
 func Fatalf(format string, args ...interface{}) {
 	if std.level <= FATAL {
-		std.Output(3, logger.FATAL, "[FATAL]", fmt.Sprintf(format, args...))
+		std.Output(3, logger.FATAL, "[FATAL]", RedactString(fmt.Sprintf(format, args...)))
 		os.Exit(1)
 	}
 }
 
 func Panic(args ...interface{}) {
 	if std.level <= PANIC {
-		std.Output(3, logger.PANIC, "[PANIC]", fmt.Sprint(args...))
+		std.Output(3, logger.PANIC, "[PANIC]", RedactString(fmt.Sprint(args...)))
 		panic("")
 	}
 }
 
 func Panicf(format string, args ...interface{}) {
 	if std.level <= PANIC {
-		std.Output(3, logger.PANIC, "[PANIC]", fmt.Sprintf(format, args...))
+		std.Output(3, logger.PANIC, "[PANIC]", RedactString(fmt.Sprintf(format, args...)))
 		panic("")
 	}
 }
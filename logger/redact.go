@@ -0,0 +1,143 @@
+package logger
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+const redacted = "***"
+
+// 需要脱敏的字段名(小写),用于key-value参数
+var sensitiveKeys = map[string]struct{}{
+	"apikey":               {},
+	"api_key":              {},
+	"secret":               {},
+	"secretkey":            {},
+	"secret_key":           {},
+	"passphrase":           {},
+	"sign":                 {},
+	"signature":            {},
+	"accesskeyid":          {},
+	"x-mbx-apikey":         {},
+	"ok-access-key":        {},
+	"ok-access-sign":       {},
+	"ok-access-passphrase": {},
+}
+
+var redactPatterns = []struct {
+	re   *regexp.Regexp
+	repl string
+}{
+	//url query: signature=xxx&AccessKeyId=xxx
+	{regexp.MustCompile(`(?i)\b(signature|sign|accesskeyid|apikey|api_key|secretkey|passphrase)=[^&\s"]+`), "${1}=" + redacted},
+	//json: "secretKey":"xxx"
+	{regexp.MustCompile(`(?i)"(apikey|api_key|secret|secretkey|secret_key|passphrase|sign|signature)"\s*:\s*"[^"]*"`), `"${1}":"` + redacted + `"`},
+	//headers: OK-ACCESS-SIGN:xxx / X-MBX-APIKEY: xxx
+	{regexp.MustCompile(`(?i)\b(ok-access-key|ok-access-sign|ok-access-passphrase|x-mbx-apikey)(["']?\s*[:=]\s*["']?)[^\s"',\]]+`), "${1}${2}" + redacted},
+}
+
+// RedactString 隐藏字符串中的api key、签名和passphrase
+func RedactString(s string) string {
+	for _, p := range redactPatterns {
+		s = p.re.ReplaceAllString(s, p.repl)
+	}
+	return s
+}
+
+type redactLogger struct {
+	next ILogger
+}
+
+// Redact 包装l,输出前隐藏msg和参数中的api key、签名和passphrase
+func Redact(l ILogger) ILogger {
+	if _, ok := l.(redactLogger); ok {
+		return l
+	}
+	return redactLogger{next: l}
+}
+
+func (r redactLogger) Debug(msg string, args ...any) {
+	r.next.Debug(RedactString(msg), redactArgs(args)...)
+}
+
+func (r redactLogger) Info(msg string, args ...any) {
+	r.next.Info(RedactString(msg), redactArgs(args)...)
+}
+
+func (r redactLogger) Warn(msg string, args ...any) {
+	r.next.Warn(RedactString(msg), redactArgs(args)...)
+}
+
+func (r redactLogger) Error(msg string, args ...any) {
+	r.next.Error(RedactString(msg), redactArgs(args)...)
+}
+
+// redactArgs 与slog一致,string后面跟着value时作为key-value,slog.Attr按Key和Value处理,其它参数单独处理
+func redactArgs(args []any) []any {
+	out := make([]any, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		if key, val, ok := slogAttr(args[i]); ok {
+			out = append(out, redactAttr(args[i], key, val)...)
+			continue
+		}
+
+		key, ok := args[i].(string)
+		if !ok || i+1 >= len(args) {
+			out = append(out, redactValue(args[i]))
+			continue
+		}
+
+		i++
+		if _, sensitive := sensitiveKeys[strings.ToLower(key)]; sensitive {
+			out = append(out, key, redacted)
+		} else {
+			out = append(out, key, redactValue(args[i]))
+		}
+	}
+	return out
+}
+
+// redactAttr 需要脱敏时把slog.Attr换成等价的key-value,其它(数值、group等)保持原样
+func redactAttr(attr any, key string, val any) []any {
+	if _, sensitive := sensitiveKeys[strings.ToLower(key)]; sensitive {
+		return []any{key, redacted}
+	}
+	switch val.(type) {
+	case string, []byte, error:
+		return []any{key, redactValue(val)}
+	}
+	return []any{attr}
+}
+
+// slogAttr 识别log/slog.Attr,返回Key和Value.Any()。go.mod为1.18,不能直接引用log/slog
+func slogAttr(v any) (key string, val any, ok bool) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Struct || rv.Type().Name() != "Attr" || !strings.HasSuffix(rv.Type().PkgPath(), "slog") {
+		return "", nil, false
+	}
+
+	k, value := rv.FieldByName("Key"), rv.FieldByName("Value")
+	if !k.IsValid() || k.Kind() != reflect.String || !value.IsValid() {
+		return "", nil, false
+	}
+
+	anyFn := value.MethodByName("Any")
+	if !anyFn.IsValid() || anyFn.Type().NumIn() != 0 || anyFn.Type().NumOut() != 1 {
+		return "", nil, false
+	}
+
+	return k.String(), anyFn.Call(nil)[0].Interface(), true
+}
+
+func redactValue(v any) any {
+	switch val := v.(type) {
+	case string:
+		return RedactString(val)
+	case []byte:
+		return RedactString(string(val))
+	case error:
+		return RedactString(val.Error())
+	}
+	return v
+}
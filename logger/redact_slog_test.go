@@ -0,0 +1,37 @@
+//go:build go1.21
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedactSlog(t *testing.T) {
+	var buf bytes.Buffer
+	l := Redact(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	l.Info("request url=/order?signature=s1gn",
+		slog.String("apiKey", "k3y123"),
+		slog.Any("secret", []byte("s3cr3t")),
+		slog.String("passphrase", "p4ss"),
+		slog.String("body", `{"sign":"s1gn"}`),
+		slog.Any("error", errors.New("OK-ACCESS-KEY:k3y123")),
+		slog.Int("count", 3),
+		"signature", "s1gn")
+
+	out := buf.String()
+	for _, secret := range []string{"k3y123", "s1gn", "s3cr3t", "p4ss"} {
+		if strings.Contains(out, secret) {
+			t.Fatalf("slog output contains %q: %s", secret, out)
+		}
+	}
+	for _, want := range []string{"apiKey=***", "secret=***", "passphrase=***", "signature=***", "count=3"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("slog output missing %q: %s", want, out)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRedactString(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "url query",
+			in:   "GET /api/v3/order?symbol=BTCUSDT&timestamp=1&signature=abcdef&AccessKeyId=key123",
+			want: "GET /api/v3/order?symbol=BTCUSDT&timestamp=1&signature=***&AccessKeyId=***",
+		},
+		{
+			name: "json body",
+			in:   `{"apiKey":"key123","secretKey":"sec","passphrase":"pass","sign":"abc","instId":"BTC-USDT"}`,
+			want: `{"apiKey":"***","secretKey":"***","passphrase":"***","sign":"***","instId":"BTC-USDT"}`,
+		},
+		{
+			name: "headers",
+			in:   `map[OK-ACCESS-KEY:key123 OK-ACCESS-SIGN:abc OK-ACCESS-PASSPHRASE:pass X-MBX-APIKEY: key456]`,
+			want: `map[OK-ACCESS-KEY:*** OK-ACCESS-SIGN:*** OK-ACCESS-PASSPHRASE:*** X-MBX-APIKEY: ***]`,
+		},
+		{
+			name: "nothing to redact",
+			in:   "symbol=BTCUSDT&side=BUY",
+			want: "symbol=BTCUSDT&side=BUY",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RedactString(tt.in); got != tt.want {
+				t.Fatalf("RedactString() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+type recordLogger struct {
+	msg  string
+	args []any
+}
+
+func (r *recordLogger) Debug(msg string, args ...any) { r.msg, r.args = msg, args }
+func (r *recordLogger) Info(msg string, args ...any)  { r.msg, r.args = msg, args }
+func (r *recordLogger) Warn(msg string, args ...any)  { r.msg, r.args = msg, args }
+func (r *recordLogger) Error(msg string, args ...any) { r.msg, r.args = msg, args }
+
+func TestRedact(t *testing.T) {
+	rec := &recordLogger{}
+	l := Redact(rec)
+
+	if Redact(l) != l {
+		t.Fatal("Redact should not wrap a redacting logger twice")
+	}
+
+	l.Error("request failed url=/order?signature=abc",
+		"apiKey", "key123",
+		"Secret", []byte("sec"),
+		"passphrase", "pass",
+		"signature", "abc",
+		"body", []byte(`{"code":"1","sign":"abc"}`),
+		"error", errors.New("invalid apikey=key123"),
+		"count", 3,
+		"dangling")
+
+	if rec.msg != "request failed url=/order?signature=***" {
+		t.Fatalf("msg = %s", rec.msg)
+	}
+
+	want := []any{
+		"apiKey", redacted,
+		"Secret", redacted,
+		"passphrase", redacted,
+		"signature", redacted,
+		"body", `{"code":"1","sign":"***"}`,
+		"error", "invalid apikey=***",
+		"count", 3,
+		"dangling",
+	}
+	if fmt.Sprint(rec.args) != fmt.Sprint(want) {
+		t.Fatalf("args = %v, want %v", rec.args, want)
+	}
+}
+
+func TestRedactFormat(t *testing.T) {
+	var buf bytes.Buffer
+	SetOut(&buf)
+	defer SetOut(os.Stdout)
+
+	Errorf("[DoAuthRequest] headers=%v, body=%s", map[string]string{"OK-ACCESS-KEY": "key123", "OK-ACCESS-SIGN": "abc"},
+		`{"secretKey":"sec","passphrase":"pass"}&signature=abc`)
+	Default().Error("request failed", "apiKey", "key123", "url", "/order?signature=abc")
+
+	out := buf.String()
+	for _, secret := range []string{"key123", "abc", "sec\"", "pass\""} {
+		if strings.Contains(out, secret) {
+			t.Fatalf("log output contains %q: %s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "signature=***") || !strings.Contains(out, "apiKey=***") {
+		t.Fatalf("log output is not redacted as expected: %s", out)
+	}
+}
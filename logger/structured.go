@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"fmt"
+	"github.com/nntaoli/go-tools/logger"
+	"strings"
+)
+
+// ILogger 结构化日志接口,方法签名与log/slog的*slog.Logger一致,可以直接传入slog.Default()
+// args为交替出现的key和value
+type ILogger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+type stdLogger struct{}
+
+// Default 输出到全局的日志,key和value格式化为key=value追加在msg之后
+func Default() ILogger {
+	return Redact(stdLogger{})
+}
+
+func (stdLogger) Debug(msg string, args ...any) {
+	std.Output(4, logger.DEBUG, "[DEBUG]", formatKV(msg, args))
+}
+
+func (stdLogger) Info(msg string, args ...any) {
+	std.Output(4, logger.INFO, "[INFO ]", formatKV(msg, args))
+}
+
+func (stdLogger) Warn(msg string, args ...any) {
+	std.Output(4, logger.WARN, "[WARN ]", formatKV(msg, args))
+}
+
+func (stdLogger) Error(msg string, args ...any) {
+	std.Output(4, logger.ERROR, "[ERROR]", formatKV(msg, args))
+}
+
+func formatKV(msg string, args []any) string {
+	var b strings.Builder
+	b.WriteString(msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 < len(args) {
+			fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+		} else {
+			fmt.Fprintf(&b, " %v", args[i])
+		}
+	}
+	return b.String()
+}
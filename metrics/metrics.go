@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+// 请求所在的层
+const (
+	Layer_Http   = "http"   //IHttpClient.DoRequest
+	Layer_Auth   = "auth"   //DoAuthRequest
+	Layer_NoAuth = "noauth" //DoNoAuthRequest
+)
+
+// 归一化的错误类型
+const (
+	ErrType_None      = "none"
+	ErrType_Timeout   = "timeout"
+	ErrType_Network   = "network"
+	ErrType_RateLimit = "rate_limit" //http 429/418
+	ErrType_Http4xx   = "http_4xx"
+	ErrType_Http5xx   = "http_5xx"
+	ErrType_Api       = "api" //交易所返回的业务错误
+)
+
+type RequestLabels struct {
+	Exchange string
+	Endpoint string //请求路径,不包含域名和参数
+	Method   string
+	Layer    string
+	ErrType  string
+}
+
+// Recorder 指标记录器,默认不记录任何指标,通过SetRecorder设置
+type Recorder interface {
+	ObserveRequest(labels RequestLabels, latency time.Duration)
+}
+
+// StatusError 带http状态码的错误,httpcli.HttpError实现了该接口
+type StatusError interface {
+	error
+	HttpStatus() int
+}
+
+type noopRecorder struct{}
+
+func (noopRecorder) ObserveRequest(RequestLabels, time.Duration) {}
+
+type recorderHolder struct {
+	Recorder
+}
+
+var recorder atomic.Value
+
+func init() {
+	recorder.Store(recorderHolder{noopRecorder{}})
+}
+
+// SetRecorder 设置全局的指标记录器,r为nil时关闭记录
+func SetRecorder(r Recorder) {
+	if r == nil {
+		r = noopRecorder{}
+	}
+	recorder.Store(recorderHolder{r})
+}
+
+func GetRecorder() Recorder {
+	return recorder.Load().(recorderHolder).Recorder
+}
+
+// ObserveRequest 记录一次请求,start为请求开始时间,err为请求返回的错误
+func ObserveRequest(exchange, layer, method, reqUrl string, start time.Time, err error) {
+	GetRecorder().ObserveRequest(RequestLabels{
+		Exchange: exchange,
+		Endpoint: endpointOf(reqUrl),
+		Method:   method,
+		Layer:    layer,
+		ErrType:  ErrorType(err),
+	}, time.Since(start))
+}
+
+// ErrorType 把错误归一化为有限的几种类型,避免指标的标签基数过大
+func ErrorType(err error) string {
+	if err == nil {
+		return ErrType_None
+	}
+
+	var statusErr StatusError
+	if errors.As(err, &statusErr) {
+		switch code := statusErr.HttpStatus(); {
+		case code == 429 || code == 418:
+			return ErrType_RateLimit
+		case code >= 500:
+			return ErrType_Http5xx
+		case code >= 400:
+			return ErrType_Http4xx
+		}
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrType_Timeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ErrType_Timeout
+		}
+		return ErrType_Network
+	}
+
+	return ErrType_Api
+}
+
+func endpointOf(reqUrl string) string {
+	u, err := url.Parse(reqUrl)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
+
+// HostOf 请求url的域名,http层没有交易所名称时使用域名作为exchange标签
+func HostOf(reqUrl string) string {
+	u, err := url.Parse(reqUrl)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
@@ -0,0 +1,58 @@
+package prometheus
+
+import (
+	"github.com/nntaoli-project/goex/v2/metrics"
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+// Collector 同时实现了metrics.Recorder和prometheus.Collector,
+// 该包是独立的module(避免goex依赖prometheus),需要单独go get,使用方法:
+//
+//	c := prometheus.NewCollector("goex")
+//	metrics.SetRecorder(c)
+//	http.Handle("/metrics", prometheus.Handler(c))
+type Collector struct {
+	requests        *prom.CounterVec
+	requestDuration *prom.HistogramVec
+}
+
+func NewCollector(namespace string) *Collector {
+	return &Collector{
+		requests: prom.NewCounterVec(prom.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Total number of exchange api requests.",
+		}, []string{"exchange", "endpoint", "method", "layer", "error_type"}),
+		requestDuration: prom.NewHistogramVec(prom.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of exchange api requests.",
+			Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"exchange", "endpoint", "method", "layer"}),
+	}
+}
+
+func (c *Collector) ObserveRequest(labels metrics.RequestLabels, latency time.Duration) {
+	c.requests.WithLabelValues(labels.Exchange, labels.Endpoint, labels.Method, labels.Layer, labels.ErrType).Inc()
+	c.requestDuration.WithLabelValues(labels.Exchange, labels.Endpoint, labels.Method, labels.Layer).Observe(latency.Seconds())
+}
+
+func (c *Collector) Describe(ch chan<- *prom.Desc) {
+	c.requests.Describe(ch)
+	c.requestDuration.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prom.Metric) {
+	c.requests.Collect(ch)
+	c.requestDuration.Collect(ch)
+}
+
+// Handler 使用独立的Registry注册collectors,返回/metrics的http.Handler
+func Handler(collectors ...prom.Collector) http.Handler {
+	reg := prom.NewRegistry()
+	reg.MustRegister(collectors...)
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg})
+}
@@ -21,6 +21,23 @@ const (
 	OrderStatus_Canceling                = 5
 )
 
+const (
+	AlgoOrderStatus_Live          AlgoOrderStatus = 1 //待生效
+	AlgoOrderStatus_Pause                         = 2 //暂停生效
+	AlgoOrderStatus_Effective                     = 3 //已生效
+	AlgoOrderStatus_PartEffective                 = 4 //部分生效
+	AlgoOrderStatus_Canceled                      = 5 //已撤销
+	AlgoOrderStatus_Failed                        = 6 //委托失败
+	AlgoOrderStatus_PartFailed                    = 7 //部分委托失败
+)
+
+const (
+	AlgoOrderType_Conditional   AlgoOrderType = "conditional"     //单向止盈止损
+	AlgoOrderType_OCO           AlgoOrderType = "oco"             //双向止盈止损
+	AlgoOrderType_Trigger       AlgoOrderType = "trigger"         //计划委托
+	AlgoOrderType_MoveOrderStop AlgoOrderType = "move_order_stop" //移动止盈止损
+)
+
 const (
 	Spot_Buy          OrderSide = "buy"
 	Spot_Sell         OrderSide = "sell"
@@ -36,6 +53,33 @@ const (
 	OrderType_opponent OrderType = "opponent"
 )
 
+const (
+	MarginMode_Cross    MarginMode = "cross"    //全仓
+	MarginMode_Isolated MarginMode = "isolated" //逐仓
+)
+
+const (
+	PositionMode_Hedge  PositionMode = "hedge"   //双向持仓
+	PositionMode_OneWay PositionMode = "one-way" //单向持仓
+)
+
+const (
+	AccountType_Funding     AccountType = "funding"      //资金账户
+	AccountType_Spot        AccountType = "spot"         //现货账户(统一账户为交易账户)
+	AccountType_Margin      AccountType = "margin"       //杠杆账户
+	AccountType_USDTFutures AccountType = "usdt_futures" //U本位合约账户
+	AccountType_CoinFutures AccountType = "coin_futures" //币本位合约账户
+)
+
+const (
+	BillType_Trade       BillType = "trade"       //成交(含已实现盈亏)
+	BillType_Fee         BillType = "fee"         //手续费
+	BillType_Funding     BillType = "funding"     //资金费
+	BillType_Transfer    BillType = "transfer"    //划转
+	BillType_Liquidation BillType = "liquidation" //强平
+	BillType_Other       BillType = "other"
+)
+
 //coin const list
 //a-z排序
 const (
@@ -9,6 +9,12 @@ type OrderSide string
 type KlinePeriod string
 
 type OrderStatus int
+type AlgoOrderType string
+type AlgoOrderStatus int
+type MarginMode string
+type PositionMode string
+type AccountType string
+type BillType string
 
 func (s OrderStatus) String() string {
 	switch s {
@@ -24,6 +30,26 @@ func (s OrderStatus) String() string {
 	return "unknown-status"
 }
 
+func (s AlgoOrderStatus) String() string {
+	switch s {
+	case 1:
+		return "live"
+	case 2:
+		return "pause"
+	case 3:
+		return "effective"
+	case 4:
+		return "part-effective"
+	case 5:
+		return "canceled"
+	case 6:
+		return "failed"
+	case 7:
+		return "part-failed"
+	}
+	return "unknown-status"
+}
+
 // OptionParameter 可选参数
 type OptionParameter struct {
 	Key   string
@@ -44,6 +70,8 @@ type CurrencyPair struct {
 	SettlementCurrency   string  `json:"settlement_currency,omitempty"`    //结算币
 	ContractAlias        string  `json:"contract_alias,omitempty"`         //交割合约alias
 	ContractDeliveryDate int64   `json:"contract_delivery_date,omitempty"` //合约交割日期
+	StrikePrice          float64 `json:"strike_price,omitempty"`           //期权行权价
+	OptionType           string  `json:"option_type,omitempty"`            //期权类型: C(call) / P(put)
 }
 
 //func (pair CurrencyPair) String() string {
@@ -123,6 +151,56 @@ type Order struct {
 	CanceledAt  int64        `json:"canceled_at,omitempty"`
 }
 
+// Trade 成交明细,公共成交数据中OrderId及手续费相关字段为空
+type Trade struct {
+	Pair      CurrencyPair `json:"pair,omitempty"`
+	Tid       string       `json:"tid,omitempty"`      //成交ID
+	OrderId   string       `json:"order_id,omitempty"` //订单ID
+	Side      OrderSide    `json:"side,omitempty"`     //成交方向
+	Price     float64      `json:"price,omitempty"`
+	Qty       float64      `json:"qty,omitempty"`
+	Fee       float64      `json:"fee,omitempty"`     //手续费,正数表示支出,负数表示返佣
+	FeeCcy    string       `json:"fee_ccy,omitempty"` //手续费币种
+	IsMaker   bool         `json:"is_maker,omitempty"`
+	Timestamp int64        `json:"t,omitempty"`
+}
+
+// AlgoTrigger 策略委托触发参数,委托价格为-1时表示触发后按市价下单
+type AlgoTrigger struct {
+	TriggerPx      float64 `json:"trigger_px,omitempty"`      //计划委托触发价
+	OrderPx        float64 `json:"order_px,omitempty"`        //计划委托委托价
+	TpTriggerPx    float64 `json:"tp_trigger_px,omitempty"`   //止盈触发价
+	TpOrderPx      float64 `json:"tp_order_px,omitempty"`     //止盈委托价
+	SlTriggerPx    float64 `json:"sl_trigger_px,omitempty"`   //止损触发价
+	SlOrderPx      float64 `json:"sl_order_px,omitempty"`     //止损委托价
+	CallbackRatio  float64 `json:"callback_ratio,omitempty"`  //移动止盈止损回调幅度比例,如0.05代表5%
+	CallbackSpread float64 `json:"callback_spread,omitempty"` //移动止盈止损回调价距
+	ActivePx       float64 `json:"active_px,omitempty"`       //移动止盈止损激活价格
+}
+
+// AlgoOrder 策略委托单: 止盈止损、计划委托、移动止盈止损
+type AlgoOrder struct {
+	AlgoTrigger
+	Pair        CurrencyPair    `json:"pair,omitempty"`
+	AlgoId      string          `json:"algo_id,omitempty"` //策略委托单ID
+	CId         string          `json:"c_id,omitempty"`    //客户端自定义ID
+	OrdId       string          `json:"ord_id,omitempty"`  //触发后生成的订单ID
+	Side        OrderSide       `json:"side,omitempty"`
+	AlgoTy      AlgoOrderType   `json:"algo_ty,omitempty"`
+	Status      AlgoOrderStatus `json:"status,omitempty"`
+	Qty         float64         `json:"qty,omitempty"`
+	ActualPx    float64         `json:"actual_px,omitempty"`  //实际委托价
+	ActualQty   float64         `json:"actual_qty,omitempty"` //实际委托量
+	CreatedAt   int64           `json:"created_at,omitempty"`
+	TriggeredAt int64           `json:"triggered_at,omitempty"` //策略委托触发时间
+}
+
+// BatchOrderResult 批量下单/撤单中单个订单的结果,Err不为nil表示该订单失败
+type BatchOrderResult struct {
+	Order Order `json:"order"`
+	Err   error `json:"-"`
+}
+
 type Account struct {
 	Coin             string  `json:"coin,omitempty"`
 	Balance          float64 `json:"balance,omitempty"`
@@ -151,3 +229,198 @@ type FuturesAccount struct {
 	Upl       float64 `json:"upl,omitempty"`
 	RiskRate  float64 `json:"risk_rate,omitempty"`
 }
+
+// OptionSummary 期权定价数据(希腊字母及波动率)
+type OptionSummary struct {
+	Pair      CurrencyPair `json:"pair,omitempty"`
+	Delta     float64      `json:"delta,omitempty"`    //币本位delta
+	Gamma     float64      `json:"gamma,omitempty"`    //币本位gamma
+	Vega      float64      `json:"vega,omitempty"`     //币本位vega
+	Theta     float64      `json:"theta,omitempty"`    //币本位theta
+	DeltaBS   float64      `json:"delta_bs,omitempty"` //BS模型delta
+	GammaBS   float64      `json:"gamma_bs,omitempty"` //BS模型gamma
+	VegaBS    float64      `json:"vega_bs,omitempty"`  //BS模型vega
+	ThetaBS   float64      `json:"theta_bs,omitempty"` //BS模型theta
+	MarkVol   float64      `json:"mark_vol,omitempty"` //标记波动率
+	BidVol    float64      `json:"bid_vol,omitempty"`  //买一价对应的隐含波动率
+	AskVol    float64      `json:"ask_vol,omitempty"`  //卖一价对应的隐含波动率
+	RealVol   float64      `json:"real_vol,omitempty"` //已实现波动率
+	FwdPx     float64      `json:"fwd_px,omitempty"`   //远期价格
+	Lever     float64      `json:"lever,omitempty"`    //杠杆倍数
+	Timestamp int64        `json:"t,omitempty"`
+}
+
+// FundingRate 永续合约资金费率
+type FundingRate struct {
+	Pair            CurrencyPair `json:"pair,omitempty"`
+	Rate            float64      `json:"rate,omitempty"`              //资金费率,历史数据为实际收取的费率
+	NextRate        float64      `json:"next_rate,omitempty"`         //预测的下一期资金费率,交易所不提供时为0
+	FundingTime     int64        `json:"funding_time,omitempty"`      //本期资金费收取时间
+	NextFundingTime int64        `json:"next_funding_time,omitempty"` //下一期资金费收取时间,历史数据为0
+	Timestamp       int64        `json:"t,omitempty"`
+}
+
+// MarkPrice 合约标记价格
+type MarkPrice struct {
+	Pair      CurrencyPair `json:"pair,omitempty"`
+	Price     float64      `json:"price,omitempty"`
+	Timestamp int64        `json:"t,omitempty"`
+}
+
+// IndexPrice 合约指数价格
+type IndexPrice struct {
+	Pair      CurrencyPair `json:"pair,omitempty"`
+	Price     float64      `json:"price,omitempty"`
+	Timestamp int64        `json:"t,omitempty"`
+}
+
+// OpenInterest 合约持仓量统计
+type OpenInterest struct {
+	Pair      CurrencyPair `json:"pair,omitempty"`
+	Qty       float64      `json:"qty,omitempty"`   //以币计价的持仓量,交易所不提供时为0
+	Value     float64      `json:"value,omitempty"` //以计价币(USD/USDT)计价的持仓价值
+	Timestamp int64        `json:"t,omitempty"`
+}
+
+// LongShortRatio 多空账户数比
+type LongShortRatio struct {
+	Pair       CurrencyPair `json:"pair,omitempty"`
+	Ratio      float64      `json:"ratio,omitempty"`       //多空比=多头账户占比/空头账户占比
+	LongRatio  float64      `json:"long_ratio,omitempty"`  //多头账户占比,交易所不提供时为0
+	ShortRatio float64      `json:"short_ratio,omitempty"` //空头账户占比,交易所不提供时为0
+	Timestamp  int64        `json:"t,omitempty"`
+}
+
+// TakerVolume 主动买入/卖出成交量
+type TakerVolume struct {
+	Pair      CurrencyPair `json:"pair,omitempty"`
+	BuyVol    float64      `json:"buy_vol,omitempty"`
+	SellVol   float64      `json:"sell_vol,omitempty"`
+	Timestamp int64        `json:"t,omitempty"`
+}
+
+// TransferRecord 账户间资金划转记录
+type TransferRecord struct {
+	Id        string      `json:"id,omitempty"` //划转ID
+	Coin      string      `json:"coin,omitempty"`
+	Amount    float64     `json:"amount,omitempty"`
+	From      AccountType `json:"from,omitempty"`
+	To        AccountType `json:"to,omitempty"`
+	Timestamp int64       `json:"t,omitempty"`
+}
+
+// CoinNetwork 币种在某条链上的充提信息
+type CoinNetwork struct {
+	Coin        string  `json:"coin,omitempty"`
+	Network     string  `json:"network,omitempty"` //链名称,提币时传入
+	CanDeposit  bool    `json:"can_deposit,omitempty"`
+	CanWithdraw bool    `json:"can_withdraw,omitempty"`
+	WithdrawFee float64 `json:"withdraw_fee,omitempty"` //提币手续费
+	WithdrawMin float64 `json:"withdraw_min,omitempty"` //单笔最小提币数量
+	WithdrawMax float64 `json:"withdraw_max,omitempty"` //单笔最大提币数量
+}
+
+// DepositAddress 充值地址
+type DepositAddress struct {
+	Coin    string `json:"coin,omitempty"`
+	Network string `json:"network,omitempty"`
+	Address string `json:"address,omitempty"`
+	Tag     string `json:"tag,omitempty"` //memo/tag,不需要时为空
+}
+
+// WithdrawRequest 提币参数
+type WithdrawRequest struct {
+	Coin    string  `json:"coin,omitempty"`
+	Network string  `json:"network,omitempty"`
+	Address string  `json:"address,omitempty"`
+	Tag     string  `json:"tag,omitempty"`
+	Amount  float64 `json:"amount,omitempty"`
+	Fee     float64 `json:"fee,omitempty"` //部分交易所(okx)需要传入手续费
+}
+
+// WalletRecord 充值/提币记录
+type WalletRecord struct {
+	Id        string  `json:"id,omitempty"`
+	Coin      string  `json:"coin,omitempty"`
+	Network   string  `json:"network,omitempty"`
+	Address   string  `json:"address,omitempty"`
+	Tag       string  `json:"tag,omitempty"`
+	Amount    float64 `json:"amount,omitempty"`
+	Fee       float64 `json:"fee,omitempty"`
+	TxId      string  `json:"tx_id,omitempty"`
+	Status    string  `json:"status,omitempty"` //交易所原始状态
+	Timestamp int64   `json:"t,omitempty"`
+}
+
+// SubAccount 子账户
+type SubAccount struct {
+	Name      string `json:"name,omitempty"` //子账户名称(okx)/邮箱(binance)/UID(huobi),作为子账户接口的参数
+	Uid       string `json:"uid,omitempty"`
+	Label     string `json:"label,omitempty"`
+	Enabled   bool   `json:"enabled,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+}
+
+// SubAccountApiKey 子账户API Key,Secret只在创建时返回
+type SubAccountApiKey struct {
+	SubAccount string   `json:"sub_account,omitempty"`
+	Label      string   `json:"label,omitempty"`
+	Key        string   `json:"key,omitempty"`
+	Secret     string   `json:"secret,omitempty"`
+	Passphrase string   `json:"passphrase,omitempty"`
+	Perm       string   `json:"perm,omitempty"` //交易所原始权限
+	Ips        []string `json:"ips,omitempty"`
+}
+
+// Bill 账户流水,每一条记录对应一次余额变动
+type Bill struct {
+	Id        string   `json:"id,omitempty"`
+	Coin      string   `json:"coin,omitempty"`
+	Symbol    string   `json:"symbol,omitempty"` //关联的交易对或合约,可能为空
+	Type      BillType `json:"type,omitempty"`
+	RawType   string   `json:"raw_type,omitempty"` //交易所原始流水类型
+	Amount    float64  `json:"amount,omitempty"`   //余额变动数量,负数为减少
+	Balance   float64  `json:"balance,omitempty"`  //变动后余额,交易所不返回时为0
+	Fee       float64  `json:"fee,omitempty"`
+	Timestamp int64    `json:"timestamp,omitempty"`
+}
+
+// TradeFee 交易手续费率,正数为收取手续费,负数为返佣
+type TradeFee struct {
+	Pair  CurrencyPair `json:"pair,omitempty"`
+	Maker float64      `json:"maker,omitempty"`
+	Taker float64      `json:"taker,omitempty"`
+}
+
+// FeeRate 限价单按maker费率估算,市价单和对手价单按taker费率估算
+func (f *TradeFee) FeeRate(orderTy OrderType) float64 {
+	if orderTy == OrderType_Limit {
+		return f.Maker
+	}
+	return f.Taker
+}
+
+// ExpectedFee 预估下单成交后的手续费(计价币),Pair设置了ContractVal时qty为合约张数(U本位)
+func (f *TradeFee) ExpectedFee(qty, price float64, orderTy OrderType) float64 {
+	return f.notional(qty, price) * f.FeeRate(orderTy)
+}
+
+// NetProceeds 预估成交后扣除手续费的计价币变动,卖出(含平多、开空)为正,买入为负
+func (f *TradeFee) NetProceeds(qty, price float64, side OrderSide, orderTy OrderType) float64 {
+	notional := f.notional(qty, price)
+	fee := notional * f.FeeRate(orderTy)
+
+	switch side {
+	case Spot_Sell, Futures_OpenSell, Futures_CloseBuy:
+		return notional - fee
+	default:
+		return -(notional + fee)
+	}
+}
+
+func (f *TradeFee) notional(qty, price float64) float64 {
+	if f.Pair.ContractVal > 0 {
+		return qty * f.Pair.ContractVal * price
+	}
+	return qty * price
+}
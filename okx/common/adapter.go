@@ -29,22 +29,40 @@ func AdaptKlinePeriodToSymbol(period model.KlinePeriod) string {
 	}
 }
 
-func adaptOrderSideToSym(s model.OrderSide) (side, posSide string) {
+// adaptOrderSideToSym 单向持仓(net_mode)时不传posSide,平仓单使用reduceOnly
+func adaptOrderSideToSym(s model.OrderSide, posMode model.PositionMode) (side, posSide string, reduceOnly bool) {
 	switch s {
 	case model.Spot_Buy:
-		return "buy", ""
+		return "buy", "", false
 	case model.Spot_Sell:
-		return "sell", ""
+		return "sell", "", false
+	}
+
+	if posMode == model.PositionMode_OneWay {
+		switch s {
+		case model.Futures_OpenBuy:
+			return "buy", "", false
+		case model.Futures_OpenSell:
+			return "sell", "", false
+		case model.Futures_CloseBuy:
+			return "sell", "", true
+		case model.Futures_CloseSell:
+			return "buy", "", true
+		}
+		return "", "", false
+	}
+
+	switch s {
 	case model.Futures_OpenBuy:
-		return "buy", "long"
+		return "buy", "long", false
 	case model.Futures_OpenSell:
-		return "sell", "short"
+		return "sell", "short", false
 	case model.Futures_CloseBuy:
-		return "sell", "long"
+		return "sell", "long", false
 	case model.Futures_CloseSell:
-		return "buy", "short"
+		return "buy", "short", false
 	}
-	return "", ""
+	return "", "", false
 }
 
 func adaptOrderTypeToSym(ty model.OrderType) string {
@@ -57,13 +75,19 @@ func adaptOrderTypeToSym(ty model.OrderType) string {
 	return string(ty)
 }
 
-func adaptSymToOrderSide(side, posSide string) model.OrderSide {
+// adaptSymToOrderSide 单向持仓(posSide=net)时根据reduceOnly区分开平仓
+func adaptSymToOrderSide(side, posSide string, reduceOnly bool) model.OrderSide {
 	if side == "buy" {
 		switch posSide {
 		case "long":
 			return model.Futures_OpenBuy
 		case "short":
 			return model.Futures_CloseSell
+		case "net":
+			if reduceOnly {
+				return model.Futures_CloseSell
+			}
+			return model.Futures_OpenBuy
 		default:
 			return model.Spot_Buy
 		}
@@ -73,6 +97,11 @@ func adaptSymToOrderSide(side, posSide string) model.OrderSide {
 			return model.Futures_CloseBuy
 		case "short":
 			return model.Futures_OpenSell
+		case "net":
+			if reduceOnly {
+				return model.Futures_CloseBuy
+			}
+			return model.Futures_OpenSell
 		default:
 			return model.Spot_Sell
 		}
@@ -112,3 +141,49 @@ func AdaptQtyOrPricePrecision(sz string) int {
 	}
 	return len(sz) - 2
 }
+
+func adaptSymToAlgoOrderStatus(st string) model.AlgoOrderStatus {
+	switch st {
+	case "live":
+		return model.AlgoOrderStatus_Live
+	case "pause":
+		return model.AlgoOrderStatus_Pause
+	case "effective":
+		return model.AlgoOrderStatus_Effective
+	case "partially_effective":
+		return model.AlgoOrderStatus_PartEffective
+	case "canceled":
+		return model.AlgoOrderStatus_Canceled
+	case "order_failed":
+		return model.AlgoOrderStatus_Failed
+	case "partially_failed":
+		return model.AlgoOrderStatus_PartFailed
+	default:
+		return model.AlgoOrderStatus(-1)
+	}
+}
+
+// adaptAccountTypeToSym 统一账户模式下现货、杠杆、合约共用交易账户(18)
+func adaptAccountTypeToSym(ty model.AccountType) string {
+	switch ty {
+	case model.AccountType_Funding:
+		return "6"
+	case model.AccountType_Spot, model.AccountType_Margin, model.AccountType_USDTFutures, model.AccountType_CoinFutures:
+		return "18"
+	}
+	return string(ty)
+}
+
+func adaptSymToBillType(ty string) model.BillType {
+	switch ty {
+	case "2", "14": //成交,大宗交易
+		return model.BillType_Trade
+	case "1", "6", "12": //划转,逐仓保证金划转,策略划转
+		return model.BillType_Transfer
+	case "5", "9": //强平,自动减仓
+		return model.BillType_Liquidation
+	case "8":
+		return model.BillType_Funding
+	}
+	return model.BillType_Other
+}
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"github.com/nntaoli-project/goex/v2/model"
+	"testing"
+)
+
+func TestAdaptOrderSideToSym(t *testing.T) {
+	tests := []struct {
+		side           model.OrderSide
+		posMode        model.PositionMode
+		wantSide       string
+		wantPosSide    string
+		wantReduceOnly bool
+	}{
+		{model.Spot_Buy, "", "buy", "", false},
+		{model.Spot_Sell, model.PositionMode_Hedge, "sell", "", false},
+
+		{model.Futures_OpenBuy, model.PositionMode_OneWay, "buy", "", false},
+		{model.Futures_OpenSell, model.PositionMode_OneWay, "sell", "", false},
+		{model.Futures_CloseBuy, model.PositionMode_OneWay, "sell", "", true},
+		{model.Futures_CloseSell, model.PositionMode_OneWay, "buy", "", true},
+
+		{model.Futures_OpenBuy, model.PositionMode_Hedge, "buy", "long", false},
+		{model.Futures_OpenSell, model.PositionMode_Hedge, "sell", "short", false},
+		{model.Futures_CloseBuy, model.PositionMode_Hedge, "sell", "long", false},
+		{model.Futures_CloseSell, model.PositionMode_Hedge, "buy", "short", false},
+
+		//没有设置持仓模式时按双向持仓处理
+		{model.Futures_OpenBuy, "", "buy", "long", false},
+
+		{"unknown", model.PositionMode_OneWay, "", "", false},
+		{"unknown", model.PositionMode_Hedge, "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.side)+"/"+string(tt.posMode), func(t *testing.T) {
+			side, posSide, reduceOnly := adaptOrderSideToSym(tt.side, tt.posMode)
+			if side != tt.wantSide || posSide != tt.wantPosSide || reduceOnly != tt.wantReduceOnly {
+				t.Fatalf("got (%s, %s, %v), want (%s, %s, %v)",
+					side, posSide, reduceOnly, tt.wantSide, tt.wantPosSide, tt.wantReduceOnly)
+			}
+		})
+	}
+}
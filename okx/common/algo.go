@@ -0,0 +1,163 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// CreateAlgoOrder 策略委托下单
+// @parameter
+//   - algoTy  conditional: 单向止盈止损, oco: 双向止盈止损, trigger: 计划委托, move_order_stop: 移动止盈止损
+//   - trigger 触发参数,只需设置对应策略类型用到的字段
+func (prv *Prv) CreateAlgoOrder(pair model.CurrencyPair, qty float64, side model.OrderSide, algoTy model.AlgoOrderType, trigger model.AlgoTrigger, opts ...model.OptionParameter) (*model.AlgoOrder, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.NewAlgoOrderUri)
+	params := url.Values{}
+
+	params.Set("instId", pair.Symbol)
+	params.Set("ordType", string(algoTy))
+	params.Set("sz", util.FloatToString(qty, pair.QtyPrecision))
+
+	posMode, err := prv.positionMode(side)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	side2, posSide, reduceOnly := adaptOrderSideToSym(side, posMode)
+	params.Set("side", side2)
+	if posSide != "" {
+		params.Set("posSide", posSide)
+	}
+	if reduceOnly {
+		params.Set("reduceOnly", "true")
+	}
+
+	setAlgoPx := func(key string, px float64) {
+		if px != 0 {
+			params.Set(key, util.FloatToString(px, pair.PricePrecision))
+		}
+	}
+	setAlgoPx("triggerPx", trigger.TriggerPx)
+	setAlgoPx("orderPx", trigger.OrderPx)
+	setAlgoPx("tpTriggerPx", trigger.TpTriggerPx)
+	setAlgoPx("tpOrdPx", trigger.TpOrderPx)
+	setAlgoPx("slTriggerPx", trigger.SlTriggerPx)
+	setAlgoPx("slOrdPx", trigger.SlOrderPx)
+	setAlgoPx("callbackSpread", trigger.CallbackSpread)
+	setAlgoPx("activePx", trigger.ActivePx)
+	if trigger.CallbackRatio != 0 {
+		params.Set("callbackRatio", util.FloatToString(trigger.CallbackRatio, 4))
+	}
+
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		prv.ExOpts.Logger.Error("create algo order failed", "error", err, "body", httpcli.LogBody(responseBody))
+		return nil, responseBody, err
+	}
+
+	ord, err := prv.UnmarshalOpts.CreateAlgoOrderResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ord.Pair = pair
+	ord.Qty = qty
+	ord.Side = side
+	ord.AlgoTy = algoTy
+	ord.AlgoTrigger = trigger
+	ord.Status = model.AlgoOrderStatus_Live
+
+	return ord, responseBody, nil
+}
+
+// CancelAlgoOrders 批量撤销策略委托单,任意一个撤单失败都会返回error
+func (prv *Prv) CancelAlgoOrders(pair model.CurrencyPair, algoIds []string, opts ...model.OptionParameter) ([]byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.CancelAlgoOrdersUri)
+
+	reqParams := make([]map[string]string, 0, len(algoIds))
+	for _, algoId := range algoIds {
+		p := map[string]string{
+			"instId": pair.Symbol,
+			"algoId": algoId,
+		}
+		for _, opt := range opts {
+			p[opt.Key] = opt.Value
+		}
+		reqParams = append(reqParams, p)
+	}
+
+	reqBody, err := json.Marshal(reqParams)
+	if err != nil {
+		return nil, err
+	}
+
+	data, responseBody, err := prv.DoAuthRawRequest(http.MethodPost, reqUrl, string(reqBody), nil)
+	if err != nil {
+		return responseBody, err
+	}
+
+	return responseBody, prv.UnmarshalOpts.CancelAlgoOrdersResponseUnmarshaler(data)
+}
+
+// GetPendingAlgoOrders 获取未触发的策略委托单
+func (prv *Prv) GetPendingAlgoOrders(pair model.CurrencyPair, algoTy model.AlgoOrderType, opts ...model.OptionParameter) ([]model.AlgoOrder, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetPendingAlgoOrdersUri)
+	params := url.Values{}
+	params.Set("instId", pair.Symbol)
+	params.Set("ordType", string(algoTy))
+
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	orders, err := prv.UnmarshalOpts.GetPendingAlgoOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	for i := range orders {
+		orders[i].Pair = pair
+	}
+
+	return orders, responseBody, nil
+}
+
+// GetHistoryAlgoOrders 获取历史策略委托单,默认查询已生效(state=effective)的委托,可以通过opts传入state或algoId
+func (prv *Prv) GetHistoryAlgoOrders(pair model.CurrencyPair, algoTy model.AlgoOrderType, opts ...model.OptionParameter) ([]model.AlgoOrder, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetHistoryAlgoOrdersUri)
+	params := url.Values{}
+	params.Set("instId", pair.Symbol)
+	params.Set("ordType", string(algoTy))
+	params.Set("state", "effective")
+
+	util.MergeOptionParams(&params, opts...)
+
+	if params.Get("algoId") != "" {
+		params.Del("state") //state和algoId只能二选一
+	}
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	orders, err := prv.UnmarshalOpts.GetHistoryAlgoOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	for i := range orders {
+		orders[i].Pair = pair
+	}
+
+	return orders, responseBody, nil
+}
@@ -0,0 +1,70 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// Transfer 资金账户与交易账户之间划转,统一账户的现货和合约共用交易账户,不需要划转
+func (prv *Prv) Transfer(coin string, amount float64, from, to model.AccountType, opts ...model.OptionParameter) (string, []byte, error) {
+	fromSym, toSym := adaptAccountTypeToSym(from), adaptAccountTypeToSym(to)
+	if fromSym == toSym {
+		return "", nil, errors.New("okx spot, margin and futures share the trading account, no need to transfer")
+	}
+
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.TransferUri)
+	params := url.Values{}
+	params.Set("ccy", coin)
+	params.Set("amt", util.FloatToString(amount, 8))
+	params.Set("from", fromSym)
+	params.Set("to", toSym)
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		return "", responseBody, err
+	}
+
+	id, err := prv.UnmarshalOpts.TransferResponseUnmarshaler(data)
+	return id, responseBody, err
+}
+
+// GetTransferHistory 通过资金账户流水(type=130/131)查询资金账户与交易账户之间的划转记录,最近3个月
+func (prv *Prv) GetTransferHistory(coin string, from, to model.AccountType, opts ...model.OptionParameter) ([]model.TransferRecord, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetTransferHistoryUri)
+	params := url.Values{}
+	if coin != "" {
+		params.Set("ccy", coin)
+	}
+
+	switch {
+	case from == model.AccountType_Funding && to != model.AccountType_Funding:
+		params.Set("type", "131") //转出至交易账户
+	case from != model.AccountType_Funding && to == model.AccountType_Funding:
+		params.Set("type", "130") //从交易账户转入
+	default:
+		return nil, nil, errors.New("okx only support transfer between funding and trading account")
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	records, err := prv.UnmarshalOpts.GetTransferHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	for i := range records {
+		records[i].From = from
+		records[i].To = to
+	}
+
+	return records, responseBody, nil
+}
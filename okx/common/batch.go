@@ -0,0 +1,128 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+)
+
+// CreateOrders 批量下单,单次最多20个订单,opts会作用于每一个订单
+func (prv *Prv) CreateOrders(orders []model.Order, opts ...model.OptionParameter) ([]model.BatchOrderResult, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.NewBatchOrdersUri)
+
+	reqParams := make([]map[string]string, 0, len(orders))
+	for _, ord := range orders {
+		p := map[string]string{
+			"instId":  ord.Pair.Symbol,
+			"ordType": adaptOrderTypeToSym(ord.OrderTy),
+			"px":      util.FloatToString(ord.Price, ord.Pair.PricePrecision),
+			"sz":      util.FloatToString(ord.Qty, ord.Pair.QtyPrecision),
+			"tag":     "86d4a3bf87bcBCDE",
+		}
+		posMode, err := prv.positionMode(ord.Side)
+		if err != nil {
+			return nil, nil, err
+		}
+		side, posSide, reduceOnly := adaptOrderSideToSym(ord.Side, posMode)
+		p["side"] = side
+		if posSide != "" {
+			p["posSide"] = posSide
+		}
+		if reduceOnly {
+			p["reduceOnly"] = "true"
+		}
+		if ord.CId != "" {
+			p["clOrdId"] = ord.CId
+		}
+		for _, opt := range opts {
+			p[opt.Key] = opt.Value
+		}
+		reqParams = append(reqParams, p)
+	}
+
+	reqBody, err := json.Marshal(reqParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, responseBody, reqErr := prv.DoAuthRawRequest(http.MethodPost, reqUrl, string(reqBody), nil)
+	if len(data) == 0 {
+		return nil, responseBody, reqErr
+	}
+
+	results, err := prv.UnmarshalOpts.CreateOrdersResponseUnmarshaler(data)
+	if err == nil && len(results) != len(orders) {
+		err = errors.New("the number of batch order results does not match the request")
+	}
+
+	if err != nil {
+		if reqErr != nil {
+			return nil, responseBody, reqErr
+		}
+		return nil, responseBody, err
+	}
+
+	for i := range results {
+		ord := orders[i]
+		ord.Id = results[i].Order.Id
+		ord.Status = model.OrderStatus_Pending
+		if results[i].Order.CId != "" {
+			ord.CId = results[i].Order.CId
+		}
+		results[i].Order = ord
+	}
+
+	return results, responseBody, nil
+}
+
+// CancelOrders 批量撤单,单次最多20个订单
+func (prv *Prv) CancelOrders(pair model.CurrencyPair, ids []string, opts ...model.OptionParameter) ([]model.BatchOrderResult, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.CancelBatchOrdersUri)
+
+	reqParams := make([]map[string]string, 0, len(ids))
+	for _, id := range ids {
+		p := map[string]string{
+			"instId": pair.Symbol,
+			"ordId":  id,
+		}
+		for _, opt := range opts {
+			p[opt.Key] = opt.Value
+		}
+		reqParams = append(reqParams, p)
+	}
+
+	reqBody, err := json.Marshal(reqParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, responseBody, reqErr := prv.DoAuthRawRequest(http.MethodPost, reqUrl, string(reqBody), nil)
+	if len(data) == 0 {
+		return nil, responseBody, reqErr
+	}
+
+	results, err := prv.UnmarshalOpts.CancelOrdersResponseUnmarshaler(data)
+	if err == nil && len(results) != len(ids) {
+		err = errors.New("the number of batch cancel results does not match the request")
+	}
+
+	if err != nil {
+		if reqErr != nil {
+			return nil, responseBody, reqErr
+		}
+		return nil, responseBody, err
+	}
+
+	for i := range results {
+		results[i].Order.Pair = pair
+		results[i].Order.Id = ids[i]
+		if results[i].Err == nil {
+			results[i].Order.Status = model.OrderStatus_Canceled
+		}
+	}
+
+	return results, responseBody, nil
+}
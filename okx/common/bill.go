@@ -0,0 +1,36 @@
+package common
+
+import (
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// GetBills 最近7天的账户流水,分页时通过opts传入after(上一页最后一条的Id)
+func (prv *Prv) GetBills(coin string, opts ...model.OptionParameter) ([]model.Bill, []byte, error) {
+	return prv.getBills(prv.UriOpts.GetBillsUri, coin, opts...)
+}
+
+// GetBillsArchive 最近3个月的账户流水,分页参数同GetBills
+func (prv *Prv) GetBillsArchive(coin string, opts ...model.OptionParameter) ([]model.Bill, []byte, error) {
+	return prv.getBills(prv.UriOpts.GetBillsArchiveUri, coin, opts...)
+}
+
+func (prv *Prv) getBills(uri, coin string, opts ...model.OptionParameter) ([]model.Bill, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, uri)
+	params := url.Values{}
+	if coin != "" {
+		params.Set("ccy", coin)
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	bills, err := prv.UnmarshalOpts.GetBillsResponseUnmarshaler(data)
+	return bills, responseBody, err
+}
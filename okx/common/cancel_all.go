@@ -0,0 +1,83 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CancelAllOrders okx没有全部撤单接口,通过查询未成交订单后批量撤单实现
+func (prv *Prv) CancelAllOrders(pair model.CurrencyPair, opts ...model.OptionParameter) ([]byte, error) {
+	const (
+		pageSize       = 100 //未成交订单接口每次最多返回100条
+		cancelPageSize = 20  //批量撤单接口每次最多20个订单
+	)
+
+	var (
+		responseBody []byte
+		errMsgs      []string
+	)
+
+	for {
+		orders, body, err := prv.GetPendingOrders(pair, opts...)
+		if err != nil {
+			return body, err
+		}
+
+		for i := 0; i < len(orders); i += cancelPageSize {
+			end := i + cancelPageSize
+			if end > len(orders) {
+				end = len(orders)
+			}
+
+			ids := make([]string, 0, end-i)
+			for _, ord := range orders[i:end] {
+				ids = append(ids, ord.Id)
+			}
+
+			results, body, err := prv.CancelOrders(pair, ids)
+			responseBody = body
+			if err != nil {
+				errMsgs = append(errMsgs, err.Error())
+				continue
+			}
+
+			for _, r := range results {
+				if r.Err != nil {
+					errMsgs = append(errMsgs, fmt.Sprintf("%s: %s", r.Order.Id, r.Err.Error()))
+				}
+			}
+		}
+
+		//撤单失败时不再继续查询,避免死循环
+		if len(orders) < pageSize || len(errMsgs) > 0 {
+			break
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return responseBody, errors.New(strings.Join(errMsgs, "; "))
+	}
+
+	return responseBody, nil
+}
+
+// CancelAllAfter 倒计时全部撤单,按账户生效(忽略pair),timeout取值为0或者[10s, 120s],精确到秒
+func (prv *Prv) CancelAllAfter(pair model.CurrencyPair, timeout time.Duration, opts ...model.OptionParameter) ([]byte, error) {
+	if timeout != 0 && (timeout < 10*time.Second || timeout > 120*time.Second) {
+		return nil, fmt.Errorf("okx cancel all after timeout %s must be 0 or in [10s, 120s]", timeout)
+	}
+
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.CancelAllAfterUri)
+	params := url.Values{}
+	params.Set("timeOut", fmt.Sprint(int64(timeout.Seconds())))
+	util.MergeOptionParams(&params, opts...)
+
+	_, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	return responseBody, err
+}
@@ -0,0 +1,36 @@
+package common
+
+import (
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// GetTradeFee 需要传入instType参数,SPOT/MARGIN按instId查询,合约按instFamily(BTC-USDT)查询
+func (prv *Prv) GetTradeFee(pair model.CurrencyPair, opt ...model.OptionParameter) (*model.TradeFee, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetTradeFeeUri)
+	params := url.Values{}
+	util.MergeOptionParams(&params, opt...)
+
+	switch params.Get("instType") {
+	case "SPOT", "MARGIN":
+		params.Set("instId", pair.Symbol)
+	default:
+		params.Set("instFamily", fmt.Sprintf("%s-%s", pair.BaseSymbol, pair.QuoteSymbol))
+	}
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	fee, err := prv.UnmarshalOpts.GetTradeFeeResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+	fee.Pair = pair
+
+	return fee, responseBody, nil
+}
@@ -0,0 +1,104 @@
+package common
+
+import (
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+func (okx *OKxV5) GetFundingRate(pair CurrencyPair, opt ...OptionParameter) (*FundingRate, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetFundingRateUri)
+	param := url.Values{}
+	param.Set("instId", pair.Symbol)
+	MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	rate, err := okx.UnmarshalOpts.GetFundingRateResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	rate.Pair = pair
+
+	return rate, responseBody, nil
+}
+
+func (okx *OKxV5) GetFundingRateHistory(pair CurrencyPair, limit int, opt ...OptionParameter) ([]FundingRate, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetFundingRateHistoryUri)
+	param := url.Values{}
+	param.Set("instId", pair.Symbol)
+	param.Set("limit", fmt.Sprint(limit))
+	MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	rates, err := okx.UnmarshalOpts.GetFundingRateHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	//统一为按时间升序
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].FundingTime < rates[j].FundingTime
+	})
+
+	for i := range rates {
+		rates[i].Pair = pair
+	}
+
+	return rates, responseBody, nil
+}
+
+// GetMarkPrice 需要通过opt传入instType(SWAP,FUTURES,OPTION,MARGIN)
+func (okx *OKxV5) GetMarkPrice(pair CurrencyPair, opt ...OptionParameter) (*MarkPrice, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetMarkPriceUri)
+	param := url.Values{}
+	param.Set("instId", pair.Symbol)
+	MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	price, err := okx.UnmarshalOpts.GetMarkPriceResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	price.Pair = pair
+
+	return price, responseBody, nil
+}
+
+// GetIndexPrice 合约的指数为标的指数,例如BTC-USDT-SWAP对应BTC-USDT
+func (okx *OKxV5) GetIndexPrice(pair CurrencyPair, opt ...OptionParameter) (*IndexPrice, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetIndexPriceUri)
+	param := url.Values{}
+	param.Set("instId", fmt.Sprintf("%s-%s", pair.BaseSymbol, pair.QuoteSymbol))
+	MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	price, err := okx.UnmarshalOpts.GetIndexPriceResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	price.Pair = pair
+
+	return price, responseBody, nil
+}
@@ -4,19 +4,24 @@ import (
 	"errors"
 	"fmt"
 	"github.com/nntaoli-project/goex/v2/httpcli"
-	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/tracing"
 	"github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
 type Prv struct {
 	*OKxV5
 	apiOpts options.ApiOptions
+
+	posModeMu sync.Mutex
+	posMode   model.PositionMode //持仓模式,通过WithPositionMode、SetPositionMode设置或者下单时查询
 }
 
 func (prv *Prv) GetAccount(coin string) (map[string]model.Account, []byte, error) {
@@ -42,17 +47,25 @@ func (prv *Prv) CreateOrder(pair model.CurrencyPair, qty, price float64, side mo
 	params.Set("px", util.FloatToString(price, pair.PricePrecision))
 	params.Set("sz", util.FloatToString(qty, pair.QtyPrecision))
 
-	side2, posSide := adaptOrderSideToSym(side)
+	posMode, err := prv.positionMode(side)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	side2, posSide, reduceOnly := adaptOrderSideToSym(side, posMode)
 	params.Set("side", side2)
 	if posSide != "" {
 		params.Set("posSide", posSide)
 	}
+	if reduceOnly {
+		params.Set("reduceOnly", "true")
+	}
 
 	util.MergeOptionParams(&params, opts...)
 
 	data, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
 	if err != nil {
-		logger.Errorf("[CreateOrder] err=%s, response=%s", err.Error(), string(data))
+		prv.ExOpts.Logger.Error("create order failed", "error", err, "body", httpcli.LogBody(responseBody))
 		return nil, responseBody, err
 	}
 
@@ -142,18 +155,87 @@ func (prv *Prv) CancelOrder(pair model.CurrencyPair, id string, opt ...model.Opt
 	return responseBody, err
 }
 
-func (prv *Prv) DoSignParam(httpMethod, apiUri, apiSecret, reqBody string) (signStr, timestamp string) {
-	timestamp = time.Now().UTC().Format("2006-01-02T15:04:05.000Z") //iso time style
+// GetFills 获取近3天的成交明细
+func (prv *Prv) GetFills(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Trade, []byte, error) {
+	return prv.getFills(prv.UriOpts.GetFillsUri, pair, opt...)
+}
+
+// GetFillsHistory 获取近3个月的成交明细,需要传入instType参数
+func (prv *Prv) GetFillsHistory(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Trade, []byte, error) {
+	return prv.getFills(prv.UriOpts.GetFillsHistoryUri, pair, opt...)
+}
+
+func (prv *Prv) getFills(uri string, pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Trade, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, uri)
+	params := url.Values{}
+	params.Set("instId", pair.Symbol)
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	trades, err := prv.UnmarshalOpts.GetFillsResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, responseBody, nil
+}
+
+// AmendOrder 修改订单,订单ID不变
+func (prv *Prv) AmendOrder(pair model.CurrencyPair, id string, newQty, newPrice float64, opt ...model.OptionParameter) (*model.Order, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.AmendOrderUri)
+	params := url.Values{}
+	params.Set("instId", pair.Symbol)
+	params.Set("ordId", id)
+	if newQty > 0 {
+		params.Set("newSz", util.FloatToString(newQty, pair.QtyPrecision))
+	}
+	if newPrice > 0 {
+		params.Set("newPx", util.FloatToString(newPrice, pair.PricePrecision))
+	}
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		prv.ExOpts.Logger.Error("amend order failed", "error", err, "body", httpcli.LogBody(responseBody))
+		return nil, responseBody, err
+	}
+
+	ord, err := prv.UnmarshalOpts.AmendOrderResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ord.Pair = pair
+	//只回填本次修改的字段,未修改的部分需要调用GetOrderInfo查询
+	if newQty > 0 {
+		ord.Qty = newQty
+	}
+	if newPrice > 0 {
+		ord.Price = newPrice
+	}
+
+	return ord, responseBody, nil
+}
+
+func (prv *Prv) DoSignParam(httpMethod, apiUri string, apiSecret []byte, reqBody string) (signStr, timestamp string) {
+	timestamp = prv.apiOpts.Now().UTC().Format("2006-01-02T15:04:05.000Z") //iso time style
 	payload := fmt.Sprintf("%s%s%s%s", timestamp, strings.ToUpper(httpMethod), apiUri, reqBody)
-	signStr, _ = util.HmacSHA256Base64Sign(apiSecret, payload)
+	signStr, _ = util.HmacSHA256Base64SignBytes(apiSecret, payload)
 	return
 }
 
 func (prv *Prv) DoAuthRequest(httpMethod, reqUrl string, params *url.Values, headers map[string]string) ([]byte, []byte, error) {
-	var (
-		reqBodyStr string
-		reqUri     string
-	)
+	var reqBodyStr string
 
 	if http.MethodGet == httpMethod {
 		reqUrl += "?" + params.Encode()
@@ -165,33 +247,78 @@ func (prv *Prv) DoAuthRequest(httpMethod, reqUrl string, params *url.Values, hea
 		reqBodyStr = string(reqBody)
 	}
 
+	data, respBody, err := prv.doAuthRawRequest(params, httpMethod, reqUrl, reqBodyStr, headers)
+	if err != nil {
+		return nil, respBody, err
+	}
+
+	return data, respBody, nil
+}
+
+// DoAuthRawRequest 直接发送已经序列化好的请求体,用于批量接口等请求体为json数组的场景
+// 与DoAuthRequest不同,接口返回code不为0时也会返回data
+func (prv *Prv) DoAuthRawRequest(httpMethod, reqUrl string, reqBodyStr string, headers map[string]string) ([]byte, []byte, error) {
+	return prv.doAuthRawRequest(nil, httpMethod, reqUrl, reqBodyStr, headers)
+}
+
+// doAuthRawRequest params仅用于设置trace span的属性
+func (prv *Prv) doAuthRawRequest(params *url.Values, httpMethod, reqUrl string, reqBodyStr string, headers map[string]string) (_, _ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(prv.GetName(), metrics.Layer_Auth, httpMethod, reqUrl, start, err)
+		httpcli.LogRequest(prv.ExOpts.Logger, prv.GetName(), httpMethod, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(prv.ExOpts.Context, prv.GetName(), httpMethod, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	var reqUri string
+
 	_url, _ := url.Parse(reqUrl)
 	reqUri = _url.RequestURI()
-	signStr, timestamp := prv.DoSignParam(httpMethod, reqUri, prv.apiOpts.Secret, reqBodyStr)
-	logger.Debugf("[DoAuthRequest] sign base64: %s, timestamp: %s", signStr, timestamp)
+
+	signSpan := tracing.StartStep(ctx, "sign")
+	cred, err := prv.apiOpts.Credential()
+	if err != nil {
+		tracing.End(signSpan, err)
+		return nil, nil, err
+	}
+	defer cred.Zero()
+
+	signStr, timestamp := prv.DoSignParam(httpMethod, reqUri, cred.Secret, reqBodyStr)
+	tracing.End(signSpan, nil)
 
 	headers = map[string]string{
 		"Content-Type": "application/json; charset=UTF-8",
 		//"Accept":               "application/json",
-		"OK-ACCESS-KEY":        prv.apiOpts.Key,
-		"OK-ACCESS-PASSPHRASE": prv.apiOpts.Passphrase,
+		"OK-ACCESS-KEY":        string(cred.Key),
+		"OK-ACCESS-PASSPHRASE": string(cred.Passphrase),
 		"OK-ACCESS-SIGN":       signStr,
 		"OK-ACCESS-TIMESTAMP":  timestamp}
+	headers = prv.simulatedHeader(headers)
 
-	respBody, err := httpcli.Cli.DoRequest(httpMethod, reqUrl, reqBodyStr, headers)
+	httpSpan := tracing.StartStep(ctx, "http")
+	respBody, err := prv.UriOpts.EndpointPool.Do(httpMethod, reqUrl, func(reqUrl string) ([]byte, error) {
+		return httpcli.DoRequestContext(ctx, httpcli.Cli, httpMethod, reqUrl, reqBodyStr, headers)
+	})
+	tracing.End(httpSpan, err)
 	if err != nil {
 		return nil, respBody, err
 	}
-	logger.Debugf("[DoAuthRequest] response body: %s", string(respBody))
+	prv.ExOpts.Logger.Debug("response", "exchange", prv.GetName(), "url", reqUri, "body", httpcli.LogBody(respBody))
 
 	var baseResp BaseResp
+	unmarshalSpan := tracing.StartStep(ctx, "unmarshal")
 	err = prv.OKxV5.UnmarshalOpts.ResponseUnmarshaler(respBody, &baseResp)
+	tracing.End(unmarshalSpan, err)
 	if err != nil {
 		return nil, respBody, err
 	}
 
 	if baseResp.Code != 0 {
-		return nil, respBody, errors.New(baseResp.Msg)
+		//批量接口部分失败时code不为0,data中包含每个订单的sCode
+		return baseResp.Data, respBody, errors.New(baseResp.Msg)
 	}
 
 	return baseResp.Data, respBody, nil
@@ -202,5 +329,67 @@ func NewPrvApi(opts ...options.ApiOption) *Prv {
 	for _, opt := range opts {
 		opt(&api.apiOpts)
 	}
+	api.posMode = api.apiOpts.PositionMode
 	return api
 }
+
+// GetPositionMode 查询账户配置中的持仓模式
+func (prv *Prv) GetPositionMode(opts ...model.OptionParameter) (model.PositionMode, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetPositionModeUri)
+	params := url.Values{}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return "", responseBody, err
+	}
+
+	mode, err := prv.UnmarshalOpts.GetPositionModeResponseUnmarshaler(data)
+	return mode, responseBody, err
+}
+
+// positionMode 合约下单使用的持仓模式,没有设置时查询一次交易所并缓存,现货下单不需要持仓模式
+func (prv *Prv) positionMode(side model.OrderSide) (model.PositionMode, error) {
+	if side == model.Spot_Buy || side == model.Spot_Sell {
+		return "", nil
+	}
+
+	prv.posModeMu.Lock()
+	defer prv.posModeMu.Unlock()
+
+	if prv.posMode == "" {
+		mode, _, err := prv.GetPositionMode()
+		if err != nil {
+			return "", err
+		}
+		prv.posMode = mode
+	}
+
+	return prv.posMode, nil
+}
+
+// SetPositionMode 设置账户的持仓模式,设置成功后合约下单按对应的模式转换买卖方向
+func (prv *Prv) SetPositionMode(mode model.PositionMode, opts ...model.OptionParameter) ([]byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.SetPositionModeUri)
+	params := url.Values{}
+	switch mode {
+	case model.PositionMode_Hedge:
+		params.Set("posMode", "long_short_mode")
+	case model.PositionMode_OneWay:
+		params.Set("posMode", "net_mode")
+	default:
+		return nil, errors.New("position mode only is PositionMode_Hedge or PositionMode_OneWay")
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	_, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		return responseBody, err
+	}
+
+	prv.posModeMu.Lock()
+	prv.posMode = mode
+	prv.posModeMu.Unlock()
+
+	return responseBody, nil
+}
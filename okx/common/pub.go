@@ -1,13 +1,17 @@
 package common
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
-	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/metrics"
 	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/tracing"
 	. "github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 func (okx *OKxV5) GetName() string {
@@ -70,6 +74,30 @@ func (okx *OKxV5) GetKline(pair CurrencyPair, period KlinePeriod, opt ...OptionP
 	return klines, responseBody, err
 }
 
+func (okx *OKxV5) GetTrades(pair CurrencyPair, limit int, opt ...OptionParameter) ([]Trade, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetTradesUri)
+	param := url.Values{}
+	param.Set("instId", pair.Symbol)
+	param.Set("limit", fmt.Sprint(limit))
+	MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	trades, err := okx.UnmarshalOpts.GetTradesResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, responseBody, nil
+}
+
 func (okx *OKxV5) GetExchangeInfo(instType string, opt ...OptionParameter) (map[string]CurrencyPair, []byte, error) {
 	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetExchangeInfoUri)
 	param := url.Values{}
@@ -86,28 +114,83 @@ func (okx *OKxV5) GetExchangeInfo(instType string, opt ...OptionParameter) (map[
 	return currencyPairMap, responseBody, err
 }
 
-func (okx *OKxV5) DoNoAuthRequest(httpMethod, reqUrl string, params *url.Values) ([]byte, []byte, error) {
+// GetUnderlyings 查询instType(FUTURES/SWAP/OPTION)的全部标的指数,如 BTC-USD
+func (okx *OKxV5) GetUnderlyings(instType string, opt ...OptionParameter) ([]string, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetUnderlyingUri)
+	param := url.Values{}
+	param.Set("instType", instType)
+	MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	//data: [["BTC-USD","ETH-USD"]]
+	var underlyings [][]string
+	if err = json.Unmarshal(data, &underlyings); err != nil {
+		return nil, responseBody, err
+	}
+	if len(underlyings) == 0 {
+		return nil, responseBody, nil
+	}
+
+	return underlyings[0], responseBody, nil
+}
+
+// GetServerTime 服务器时间(毫秒)
+func (okx *OKxV5) GetServerTime(opt ...OptionParameter) (int64, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, okx.UriOpts.GetServerTimeUri)
+	param := url.Values{}
+	MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+	if err != nil {
+		return 0, responseBody, err
+	}
+
+	ts, err := okx.UnmarshalOpts.GetServerTimeResponseUnmarshaler(data)
+	return ts, responseBody, err
+}
+
+func (okx *OKxV5) DoNoAuthRequest(httpMethod, reqUrl string, params *url.Values) (_, _ []byte, err error) {
+	defer func(start time.Time) {
+		metrics.ObserveRequest(okx.GetName(), metrics.Layer_NoAuth, httpMethod, reqUrl, start, err)
+		LogRequest(okx.ExOpts.Logger, okx.GetName(), httpMethod, reqUrl, start, err)
+	}(time.Now())
+
+	ctx, span := tracing.Start(okx.ExOpts.Context, okx.GetName(), httpMethod, reqUrl, params)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	reqBody := ""
 	if http.MethodGet == httpMethod {
 		reqUrl += "?" + params.Encode()
 	}
 
-	responseBody, err := Cli.DoRequest(httpMethod, reqUrl, reqBody, nil)
+	httpSpan := tracing.StartStep(ctx, "http")
+	responseBody, err := okx.UriOpts.EndpointPool.Do(httpMethod, reqUrl, func(reqUrl string) ([]byte, error) {
+		return DoRequestContext(ctx, Cli, httpMethod, reqUrl, reqBody, okx.simulatedHeader(nil))
+	})
+	tracing.End(httpSpan, err)
 	if err != nil {
 		return nil, responseBody, err
 	}
 
 	var baseResp BaseResp
+	unmarshalSpan := tracing.StartStep(ctx, "unmarshal")
 	err = okx.UnmarshalOpts.ResponseUnmarshaler(responseBody, &baseResp)
+	tracing.End(unmarshalSpan, err)
 	if err != nil {
 		return responseBody, responseBody, err
 	}
 
 	if baseResp.Code == 0 {
-		logger.Debugf("[DoNoAuthRequest] response=%s", string(responseBody))
+		okx.ExOpts.Logger.Debug("response", "exchange", okx.GetName(), "url", reqUrl, "body", LogBody(responseBody))
 		return baseResp.Data, responseBody, nil
 	}
 
-	logger.Debugf("[DoNoAuthRequest] error=%s", baseResp.Msg)
-	return nil, responseBody, err
+	okx.ExOpts.Logger.Debug("response error", "exchange", okx.GetName(), "url", reqUrl, "code", baseResp.Code, "msg", baseResp.Msg)
+	return nil, responseBody, errors.New(baseResp.Msg)
 }
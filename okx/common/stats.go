@@ -0,0 +1,102 @@
+package common
+
+import (
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"sort"
+)
+
+// GetOpenInterestHistory 交易大数据接口按币种统计全部合约的持仓,只返回持仓价值(USD)
+//
+//	period 支持Kline_5min,Kline_1h,Kline_1day
+func (okx *OKxV5) GetOpenInterestHistory(pair CurrencyPair, period KlinePeriod, limit int, opt ...OptionParameter) ([]OpenInterest, []byte, error) {
+	data, responseBody, err := okx.doStatRequest(okx.UriOpts.GetOpenInterestHistoryUri, pair, period, opt...)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ois, err := okx.UnmarshalOpts.GetOpenInterestHistoryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	//rubik接口按时间倒序返回,统一为升序后保留最近的limit条
+	sort.Slice(ois, func(i, j int) bool {
+		return ois[i].Timestamp < ois[j].Timestamp
+	})
+	if limit > 0 && len(ois) > limit {
+		ois = ois[len(ois)-limit:]
+	}
+
+	for i := range ois {
+		ois[i].Pair = pair
+	}
+
+	return ois, responseBody, nil
+}
+
+// GetLongShortRatio 按币种统计的全部合约账户多空比,只返回Ratio
+func (okx *OKxV5) GetLongShortRatio(pair CurrencyPair, period KlinePeriod, limit int, opt ...OptionParameter) ([]LongShortRatio, []byte, error) {
+	data, responseBody, err := okx.doStatRequest(okx.UriOpts.GetLongShortRatioUri, pair, period, opt...)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ratios, err := okx.UnmarshalOpts.GetLongShortRatioResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	sort.Slice(ratios, func(i, j int) bool {
+		return ratios[i].Timestamp < ratios[j].Timestamp
+	})
+	if limit > 0 && len(ratios) > limit {
+		ratios = ratios[len(ratios)-limit:]
+	}
+
+	for i := range ratios {
+		ratios[i].Pair = pair
+	}
+
+	return ratios, responseBody, nil
+}
+
+// GetTakerVolume 默认统计合约(instType=CONTRACTS),可以通过opt传入instType=SPOT
+func (okx *OKxV5) GetTakerVolume(pair CurrencyPair, period KlinePeriod, limit int, opt ...OptionParameter) ([]TakerVolume, []byte, error) {
+	opt = append([]OptionParameter{{Key: "instType", Value: "CONTRACTS"}}, opt...)
+	data, responseBody, err := okx.doStatRequest(okx.UriOpts.GetTakerVolumeUri, pair, period, opt...)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	vols, err := okx.UnmarshalOpts.GetTakerVolumeResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	sort.Slice(vols, func(i, j int) bool {
+		return vols[i].Timestamp < vols[j].Timestamp
+	})
+	if limit > 0 && len(vols) > limit {
+		vols = vols[len(vols)-limit:]
+	}
+
+	for i := range vols {
+		vols[i].Pair = pair
+	}
+
+	return vols, responseBody, nil
+}
+
+func (okx *OKxV5) doStatRequest(uri string, pair CurrencyPair, period KlinePeriod, opt ...OptionParameter) ([]byte, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", okx.UriOpts.Endpoint, uri)
+	param := url.Values{}
+	param.Set("ccy", pair.BaseSymbol)
+	param.Set("period", AdaptKlinePeriodToSymbol(period))
+	MergeOptionParams(&param, opt...)
+
+	return okx.DoNoAuthRequest(http.MethodGet, reqUrl, &param)
+}
@@ -0,0 +1,104 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (prv *Prv) GetSubAccounts(opts ...model.OptionParameter) ([]model.SubAccount, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetSubAccountsUri)
+	params := url.Values{}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	subAccounts, err := prv.UnmarshalOpts.GetSubAccountsResponseUnmarshaler(data)
+	return subAccounts, responseBody, err
+}
+
+// GetSubAccountBalance 获取子账户交易账户余额,subAccount为子账户名称
+func (prv *Prv) GetSubAccountBalance(subAccount string, opts ...model.OptionParameter) (map[string]model.Account, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetSubAccountBalanceUri)
+	params := url.Values{}
+	params.Set("subAcct", subAccount)
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	balances, err := prv.UnmarshalOpts.GetAccountResponseUnmarshaler(data)
+	return balances, responseBody, err
+}
+
+// SubAccountTransfer 母子账户资金账户之间划转,可以通过opts的from/to参数指定交易账户(18)
+func (prv *Prv) SubAccountTransfer(coin string, amount float64, fromSub, toSub string, opts ...model.OptionParameter) (string, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.SubAccountTransferUri)
+	params := url.Values{}
+	params.Set("ccy", coin)
+	params.Set("amt", util.FloatToString(amount, 8))
+	params.Set("from", "6")
+	params.Set("to", "6")
+
+	switch {
+	case fromSub == "" && toSub != "":
+		params.Set("type", "0") //母账户转子账户
+		params.Set("toSubAccount", toSub)
+	case fromSub != "" && toSub == "":
+		params.Set("type", "1") //子账户转母账户
+		params.Set("fromSubAccount", fromSub)
+	case fromSub != "" && toSub != "":
+		params.Set("type", "2") //子账户转子账户
+		params.Set("fromSubAccount", fromSub)
+		params.Set("toSubAccount", toSub)
+	default:
+		return "", nil, errors.New("fromSub and toSub can not both be master account")
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		return "", responseBody, err
+	}
+
+	id, err := prv.UnmarshalOpts.TransferResponseUnmarshaler(data)
+	return id, responseBody, err
+}
+
+// CreateSubAccountApiKey 创建子账户API Key,必须通过opts传入passphrase参数
+func (prv *Prv) CreateSubAccountApiKey(subAccount, label string, canTrade bool, ips []string, opts ...model.OptionParameter) (*model.SubAccountApiKey, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.CreateSubAccountApiKeyUri)
+	params := url.Values{}
+	params.Set("subAcct", subAccount)
+	params.Set("label", label)
+	if canTrade {
+		params.Set("perm", "read_only,trade")
+	} else {
+		params.Set("perm", "read_only")
+	}
+	if len(ips) > 0 {
+		params.Set("ip", strings.Join(ips, ","))
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	if params.Get("passphrase") == "" {
+		return nil, nil, errors.New("okx create sub account api key need passphrase parameter")
+	}
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	apiKey, err := prv.UnmarshalOpts.CreateSubAccountApiKeyResponseUnmarshaler(data)
+	return apiKey, responseBody, err
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/nntaoli-project/goex/v2/logger"
 	. "github.com/nntaoli-project/goex/v2/model"
 	"github.com/spf13/cast"
+	"math"
+	"strings"
 	"time"
 )
 
@@ -128,6 +130,33 @@ func (un *RespUnmarshaler) UnmarshalGetKlineResponse(data []byte) ([]Kline, erro
 	return klines, err
 }
 
+func (un *RespUnmarshaler) UnmarshalGetTradesResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var trade Trade
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "tradeId":
+				trade.Tid = valStr
+			case "px":
+				trade.Price = cast.ToFloat64(valStr)
+			case "sz":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "side":
+				trade.Side = adaptSymToOrderSide(valStr, "", false)
+			case "ts":
+				trade.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
+
 func (un *RespUnmarshaler) UnmarshalCreateOrderResponse(data []byte) (*Order, error) {
 	var ord = new(Order)
 	err := jsonparser.ObjectEach(data[1:len(data)-1], func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
@@ -143,6 +172,54 @@ func (un *RespUnmarshaler) UnmarshalCreateOrderResponse(data []byte) (*Order, er
 	return ord, err
 }
 
+func (un *RespUnmarshaler) UnmarshalCreateOrdersResponse(data []byte) ([]BatchOrderResult, error) {
+	var results []BatchOrderResult
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			result     BatchOrderResult
+			sCode, msg string
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "ordId":
+				result.Order.Id = valStr
+			case "clOrdId":
+				result.Order.CId = valStr
+			case "sCode":
+				sCode = valStr
+			case "sMsg":
+				msg = valStr
+			}
+			return nil
+		})
+		if sCode != "0" {
+			result.Err = fmt.Errorf("sCode=%s, sMsg=%s", sCode, msg)
+		}
+		results = append(results, result)
+	})
+
+	return results, err
+}
+
+func (un *RespUnmarshaler) UnmarshalCancelOrdersResponse(data []byte) ([]BatchOrderResult, error) {
+	return un.UnmarshalCreateOrdersResponse(data)
+}
+
+func (un *RespUnmarshaler) UnmarshalAmendOrderResponse(data []byte) (*Order, error) {
+	results, err := un.UnmarshalCreateOrdersResponse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, errors.New(string(data))
+	}
+
+	return &results[0].Order, results[0].Err
+}
+
 func (un *RespUnmarshaler) UnmarshalGetPendingOrdersResponse(data []byte) ([]Order, error) {
 	var (
 		orders []Order
@@ -165,7 +242,10 @@ func (un *RespUnmarshaler) UnmarshalGetHistoryOrdersResponse(data []byte) ([]Ord
 }
 
 func (un *RespUnmarshaler) UnmarshalGetOrderInfoResponse(data []byte) (ord *Order, err error) {
-	var side, posSide string
+	var (
+		side, posSide string
+		reduceOnly    bool
+	)
 	var utime int64
 	ord = new(Order)
 
@@ -194,6 +274,8 @@ func (un *RespUnmarshaler) UnmarshalGetOrderInfoResponse(data []byte) (ord *Orde
 			side = valStr
 		case "posSide":
 			posSide = valStr
+		case "reduceOnly":
+			reduceOnly = valStr == "true"
 		case "ordType":
 			ord.OrderTy = adaptSymToOrderTy(valStr)
 		case "state":
@@ -204,7 +286,7 @@ func (un *RespUnmarshaler) UnmarshalGetOrderInfoResponse(data []byte) (ord *Orde
 		return nil
 	})
 
-	ord.Side = adaptSymToOrderSide(side, posSide)
+	ord.Side = adaptSymToOrderSide(side, posSide, reduceOnly)
 	if ord.Status == OrderStatus_Canceled {
 		ord.CanceledAt = utime
 		if ord.ExecutedQty > 0 {
@@ -219,6 +301,49 @@ func (un *RespUnmarshaler) UnmarshalGetOrderInfoResponse(data []byte) (ord *Orde
 	return
 }
 
+func (un *RespUnmarshaler) UnmarshalGetFillsResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			trade         Trade
+			side, posSide string
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "instId":
+				trade.Pair.Symbol = valStr
+			case "tradeId":
+				trade.Tid = valStr
+			case "ordId":
+				trade.OrderId = valStr
+			case "side":
+				side = valStr
+			case "posSide":
+				posSide = valStr
+			case "fillPx":
+				trade.Price = cast.ToFloat64(valStr)
+			case "fillSz":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "fee":
+				trade.Fee = -cast.ToFloat64(valStr) //okx手续费扣除为负数
+			case "feeCcy":
+				trade.FeeCcy = valStr
+			case "execType":
+				trade.IsMaker = valStr == "M"
+			case "ts":
+				trade.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		trade.Side = adaptSymToOrderSide(side, posSide, false) //成交明细没有reduceOnly,单向持仓时按开仓处理
+		trades = append(trades, trade)
+	})
+
+	return trades, err
+}
+
 func (un *RespUnmarshaler) UnmarshalGetAccountResponse(data []byte) (map[string]Account, error) {
 	var accMap = make(map[string]Account, 2)
 
@@ -335,6 +460,304 @@ func (un *RespUnmarshaler) UnmarshalGetPositionsResponse(data []byte) ([]Futures
 	return positions, err
 }
 
+func (un *RespUnmarshaler) UnmarshalGetLeverageResponse(data []byte) (float64, error) {
+	lever, err := jsonparser.GetString(data, "[0]", "lever")
+	if err != nil {
+		return 0, err
+	}
+	return cast.ToFloat64(lever), nil
+}
+
+// UnmarshalGetPositionModeResponse long_short_mode为双向持仓,net_mode为单向持仓
+func (un *RespUnmarshaler) UnmarshalGetPositionModeResponse(data []byte) (PositionMode, error) {
+	posMode, err := jsonparser.GetString(data, "[0]", "posMode")
+	if err != nil {
+		return "", err
+	}
+	switch posMode {
+	case "long_short_mode":
+		return PositionMode_Hedge, nil
+	case "net_mode":
+		return PositionMode_OneWay, nil
+	}
+	return "", fmt.Errorf("unknown position mode: %s", posMode)
+}
+
+func (un *RespUnmarshaler) UnmarshalTransferResponse(data []byte) (string, error) {
+	return jsonparser.GetString(data, "[0]", "transId")
+}
+
+// UnmarshalGetTransferHistoryResponse 解析资金账户流水,From/To由调用方根据查询的流水类型设置
+func (un *RespUnmarshaler) UnmarshalGetTransferHistoryResponse(data []byte) ([]TransferRecord, error) {
+	var records []TransferRecord
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var record TransferRecord
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "billId":
+				record.Id = valStr
+			case "ccy":
+				record.Coin = valStr
+			case "balChg":
+				record.Amount = math.Abs(cast.ToFloat64(valStr))
+			case "ts":
+				record.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		records = append(records, record)
+	})
+
+	return records, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetCoinNetworksResponse(data []byte) ([]CoinNetwork, error) {
+	var networks []CoinNetwork
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			network CoinNetwork
+			fee     string
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "ccy":
+				network.Coin = valStr
+			case "chain":
+				network.Network = valStr
+			case "canDep":
+				network.CanDeposit = valStr == "true"
+			case "canWd":
+				network.CanWithdraw = valStr == "true"
+			case "minFee":
+				if fee == "" {
+					fee = valStr
+				}
+			case "fee":
+				fee = valStr
+			case "minWd":
+				network.WithdrawMin = cast.ToFloat64(valStr)
+			case "maxWd":
+				network.WithdrawMax = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		network.WithdrawFee = cast.ToFloat64(fee)
+		networks = append(networks, network)
+	})
+
+	return networks, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetDepositAddressResponse(data []byte) ([]DepositAddress, error) {
+	var addresses []DepositAddress
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var addr DepositAddress
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "ccy":
+				addr.Coin = valStr
+			case "chain":
+				addr.Network = valStr
+			case "addr":
+				addr.Address = valStr
+			case "tag", "memo":
+				if valStr != "" {
+					addr.Tag = valStr
+				}
+			}
+			return nil
+		})
+		addresses = append(addresses, addr)
+	})
+
+	return addresses, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetDepositHistoryResponse(data []byte) ([]WalletRecord, error) {
+	return un.unmarshalWalletRecords(data, "depId")
+}
+
+func (un *RespUnmarshaler) UnmarshalGetWithdrawHistoryResponse(data []byte) ([]WalletRecord, error) {
+	return un.unmarshalWalletRecords(data, "wdId")
+}
+
+func (un *RespUnmarshaler) unmarshalWalletRecords(data []byte, idKey string) ([]WalletRecord, error) {
+	var records []WalletRecord
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var record WalletRecord
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case idKey:
+				record.Id = valStr
+			case "ccy":
+				record.Coin = valStr
+			case "chain":
+				record.Network = valStr
+			case "to":
+				record.Address = valStr
+			case "tag", "memo":
+				if valStr != "" {
+					record.Tag = valStr
+				}
+			case "amt":
+				record.Amount = cast.ToFloat64(valStr)
+			case "fee":
+				record.Fee = cast.ToFloat64(valStr)
+			case "txId":
+				record.TxId = valStr
+			case "state":
+				record.Status = valStr
+			case "ts":
+				record.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		records = append(records, record)
+	})
+
+	return records, err
+}
+
+func (un *RespUnmarshaler) UnmarshalWithdrawResponse(data []byte) (string, error) {
+	return jsonparser.GetString(data, "[0]", "wdId")
+}
+
+func (un *RespUnmarshaler) UnmarshalGetSubAccountsResponse(data []byte) ([]SubAccount, error) {
+	var subAccounts []SubAccount
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var sub SubAccount
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "subAcct":
+				sub.Name = valStr
+			case "uid":
+				sub.Uid = valStr
+			case "label":
+				sub.Label = valStr
+			case "enable":
+				sub.Enabled = cast.ToBool(valStr)
+			case "ts":
+				sub.CreatedAt = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		subAccounts = append(subAccounts, sub)
+	})
+
+	return subAccounts, err
+}
+
+func (un *RespUnmarshaler) UnmarshalCreateSubAccountApiKeyResponse(data []byte) (*SubAccountApiKey, error) {
+	var apiKey SubAccountApiKey
+
+	err := jsonparser.ObjectEach(data[1:len(data)-1], func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "subAcct":
+			apiKey.SubAccount = valStr
+		case "label":
+			apiKey.Label = valStr
+		case "apiKey":
+			apiKey.Key = valStr
+		case "secretKey":
+			apiKey.Secret = valStr
+		case "passphrase":
+			apiKey.Passphrase = valStr
+		case "perm":
+			apiKey.Perm = valStr
+		case "ip":
+			if valStr != "" {
+				apiKey.Ips = strings.Split(valStr, ",")
+			}
+		}
+		return nil
+	})
+
+	return &apiKey, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetBillsResponse(data []byte) ([]Bill, error) {
+	var bills []Bill
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var bill Bill
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "billId":
+				bill.Id = valStr
+			case "ccy":
+				bill.Coin = valStr
+			case "instId":
+				bill.Symbol = valStr
+			case "type":
+				bill.RawType = valStr
+				bill.Type = adaptSymToBillType(valStr)
+			case "balChg":
+				bill.Amount = cast.ToFloat64(valStr)
+			case "bal":
+				bill.Balance = cast.ToFloat64(valStr)
+			case "fee":
+				bill.Fee = math.Abs(cast.ToFloat64(valStr))
+			case "ts":
+				bill.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		bills = append(bills, bill)
+	})
+
+	return bills, err
+}
+
+// UnmarshalGetTradeFeeResponse okx返回的费率负数为收取手续费,U本位合约使用makerU/takerU
+func (un *RespUnmarshaler) UnmarshalGetTradeFeeResponse(data []byte) (*TradeFee, error) {
+	var (
+		fee            TradeFee
+		makerU, takerU string
+	)
+
+	err := jsonparser.ObjectEach(data[1:len(data)-1], func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "maker":
+			fee.Maker = -cast.ToFloat64(valStr)
+		case "taker":
+			fee.Taker = -cast.ToFloat64(valStr)
+		case "makerU":
+			makerU = valStr
+		case "takerU":
+			takerU = valStr
+		}
+		return nil
+	})
+
+	if makerU != "" && takerU != "" {
+		fee.Maker = -cast.ToFloat64(makerU)
+		fee.Taker = -cast.ToFloat64(takerU)
+	}
+
+	return &fee, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetServerTimeResponse(data []byte) (int64, error) {
+	ts, err := jsonparser.GetString(data, "[0]", "ts")
+	if err != nil {
+		return 0, err
+	}
+	return cast.ToInt64E(ts)
+}
+
 func (un *RespUnmarshaler) UnmarshalGetExchangeInfoResponse(data []byte) (map[string]CurrencyPair, error) {
 	var (
 		err             error
@@ -347,6 +770,7 @@ func (un *RespUnmarshaler) UnmarshalGetExchangeInfoResponse(data []byte) (map[st
 			instTy       string
 			ctValCcy     string
 			settleCcy    string
+			uly          string
 		)
 
 		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
@@ -378,6 +802,12 @@ func (un *RespUnmarshaler) UnmarshalGetExchangeInfoResponse(data []byte) (map[st
 				currencyPair.ContractAlias = valStr
 			case "expTime":
 				currencyPair.ContractDeliveryDate = cast.ToInt64(valStr)
+			case "stk":
+				currencyPair.StrikePrice = cast.ToFloat64(valStr)
+			case "optType":
+				currencyPair.OptionType = valStr
+			case "uly":
+				uly = valStr
 			}
 			return nil
 		})
@@ -393,6 +823,17 @@ func (un *RespUnmarshaler) UnmarshalGetExchangeInfoResponse(data []byte) (map[st
 			currencyPair.QuoteSymbol = ctValCcy
 		}
 
+		//同一标的下的期权合约很多,直接使用instId作为key
+		if instTy == "OPTION" {
+			ulyArr := strings.Split(uly, "-")
+			if len(ulyArr) == 2 {
+				currencyPair.BaseSymbol = ulyArr[0]
+				currencyPair.QuoteSymbol = ulyArr[1]
+			}
+			currencyPairMap[currencyPair.Symbol] = currencyPair
+			return
+		}
+
 		k := fmt.Sprintf("%s%s%s", currencyPair.BaseSymbol, currencyPair.QuoteSymbol, currencyPair.ContractAlias)
 		currencyPairMap[k] = currencyPair
 	})
@@ -400,6 +841,347 @@ func (un *RespUnmarshaler) UnmarshalGetExchangeInfoResponse(data []byte) (map[st
 	return currencyPairMap, err
 }
 
+func (un *RespUnmarshaler) UnmarshalGetOptionSummaryResponse(data []byte) ([]OptionSummary, error) {
+	var summaries []OptionSummary
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var summary OptionSummary
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "instId":
+				summary.Pair.Symbol = valStr
+			case "delta":
+				summary.Delta = cast.ToFloat64(valStr)
+			case "gamma":
+				summary.Gamma = cast.ToFloat64(valStr)
+			case "vega":
+				summary.Vega = cast.ToFloat64(valStr)
+			case "theta":
+				summary.Theta = cast.ToFloat64(valStr)
+			case "deltaBS":
+				summary.DeltaBS = cast.ToFloat64(valStr)
+			case "gammaBS":
+				summary.GammaBS = cast.ToFloat64(valStr)
+			case "vegaBS":
+				summary.VegaBS = cast.ToFloat64(valStr)
+			case "thetaBS":
+				summary.ThetaBS = cast.ToFloat64(valStr)
+			case "markVol":
+				summary.MarkVol = cast.ToFloat64(valStr)
+			case "bidVol":
+				summary.BidVol = cast.ToFloat64(valStr)
+			case "askVol":
+				summary.AskVol = cast.ToFloat64(valStr)
+			case "realVol":
+				summary.RealVol = cast.ToFloat64(valStr)
+			case "fwdPx":
+				summary.FwdPx = cast.ToFloat64(valStr)
+			case "lever":
+				summary.Lever = cast.ToFloat64(valStr)
+			case "ts":
+				summary.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		summaries = append(summaries, summary)
+	})
+
+	return summaries, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetFundingRateResponse(data []byte) (*FundingRate, error) {
+	rates, err := un.UnmarshalGetFundingRateHistoryResponse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rates) == 0 {
+		return nil, errors.New(string(data))
+	}
+
+	return &rates[0], nil
+}
+
+func (un *RespUnmarshaler) UnmarshalGetFundingRateHistoryResponse(data []byte) ([]FundingRate, error) {
+	var rates []FundingRate
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			rate         FundingRate
+			realizedRate string
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "fundingRate":
+				rate.Rate = cast.ToFloat64(valStr)
+			case "realizedRate":
+				realizedRate = valStr
+			case "nextFundingRate":
+				rate.NextRate = cast.ToFloat64(valStr)
+			case "fundingTime":
+				rate.FundingTime = cast.ToInt64(valStr)
+			case "nextFundingTime":
+				rate.NextFundingTime = cast.ToInt64(valStr)
+			case "ts":
+				rate.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		if realizedRate != "" { //历史数据取实际收取的费率
+			rate.Rate = cast.ToFloat64(realizedRate)
+		}
+		rates = append(rates, rate)
+	})
+
+	return rates, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetMarkPriceResponse(data []byte) (*MarkPrice, error) {
+	price := new(MarkPrice)
+
+	markPx, err := jsonparser.GetString(data, "[0]", "markPx")
+	if err != nil {
+		return nil, err
+	}
+	ts, _ := jsonparser.GetString(data, "[0]", "ts")
+
+	price.Price = cast.ToFloat64(markPx)
+	price.Timestamp = cast.ToInt64(ts)
+
+	return price, nil
+}
+
+func (un *RespUnmarshaler) UnmarshalGetIndexPriceResponse(data []byte) (*IndexPrice, error) {
+	price := new(IndexPrice)
+
+	idxPx, err := jsonparser.GetString(data, "[0]", "idxPx")
+	if err != nil {
+		return nil, err
+	}
+	ts, _ := jsonparser.GetString(data, "[0]", "ts")
+
+	price.Price = cast.ToFloat64(idxPx)
+	price.Timestamp = cast.ToInt64(ts)
+
+	return price, nil
+}
+
+// UnmarshalGetOpenInterestHistoryResponse 数据格式 [ts,oi,vol],oi及vol以USD计价
+func (un *RespUnmarshaler) UnmarshalGetOpenInterestHistoryResponse(data []byte) ([]OpenInterest, error) {
+	var (
+		items [][]string
+		ois   []OpenInterest
+	)
+
+	err := un.UnmarshalResponse(data, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if len(item) < 2 {
+			continue
+		}
+		ois = append(ois, OpenInterest{
+			Value:     cast.ToFloat64(item[1]),
+			Timestamp: cast.ToInt64(item[0]),
+		})
+	}
+
+	return ois, nil
+}
+
+// UnmarshalGetLongShortRatioResponse 数据格式 [ts,ratio]
+func (un *RespUnmarshaler) UnmarshalGetLongShortRatioResponse(data []byte) ([]LongShortRatio, error) {
+	var (
+		items  [][]string
+		ratios []LongShortRatio
+	)
+
+	err := un.UnmarshalResponse(data, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if len(item) < 2 {
+			continue
+		}
+		ratios = append(ratios, LongShortRatio{
+			Ratio:     cast.ToFloat64(item[1]),
+			Timestamp: cast.ToInt64(item[0]),
+		})
+	}
+
+	return ratios, nil
+}
+
+// UnmarshalGetTakerVolumeResponse 数据格式 [ts,sellVol,buyVol]
+func (un *RespUnmarshaler) UnmarshalGetTakerVolumeResponse(data []byte) ([]TakerVolume, error) {
+	var (
+		items [][]string
+		vols  []TakerVolume
+	)
+
+	err := un.UnmarshalResponse(data, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if len(item) < 3 {
+			continue
+		}
+		vols = append(vols, TakerVolume{
+			SellVol:   cast.ToFloat64(item[1]),
+			BuyVol:    cast.ToFloat64(item[2]),
+			Timestamp: cast.ToInt64(item[0]),
+		})
+	}
+
+	return vols, nil
+}
+
+func (un *RespUnmarshaler) UnmarshalCreateAlgoOrderResponse(data []byte) (*AlgoOrder, error) {
+	var (
+		ord   = new(AlgoOrder)
+		sCode string
+		sMsg  string
+	)
+
+	err := jsonparser.ObjectEach(data[1:len(data)-1], func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(value)
+		switch string(key) {
+		case "algoId":
+			ord.AlgoId = valStr
+		case "algoClOrdId":
+			ord.CId = valStr
+		case "sCode":
+			sCode = valStr
+		case "sMsg":
+			sMsg = valStr
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if sCode != "" && sCode != "0" {
+		return nil, errors.New(sMsg)
+	}
+
+	return ord, nil
+}
+
+func (un *RespUnmarshaler) UnmarshalGetPendingAlgoOrdersResponse(data []byte) ([]AlgoOrder, error) {
+	var orders []AlgoOrder
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		ord, err := un.unmarshalAlgoOrder(value)
+		if err != nil {
+			return
+		}
+		orders = append(orders, *ord)
+	})
+
+	return orders, err
+}
+
+func (un *RespUnmarshaler) UnmarshalGetHistoryAlgoOrdersResponse(data []byte) ([]AlgoOrder, error) {
+	return un.UnmarshalGetPendingAlgoOrdersResponse(data)
+}
+
+func (un *RespUnmarshaler) unmarshalAlgoOrder(data []byte) (*AlgoOrder, error) {
+	var (
+		ord           = new(AlgoOrder)
+		side, posSide string
+		reduceOnly    bool
+	)
+
+	err := jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(value)
+		switch string(key) {
+		case "instId":
+			ord.Pair.Symbol = valStr
+		case "algoId":
+			ord.AlgoId = valStr
+		case "algoClOrdId":
+			ord.CId = valStr
+		case "ordId":
+			ord.OrdId = valStr
+		case "ordType":
+			ord.AlgoTy = AlgoOrderType(valStr)
+		case "state":
+			ord.Status = adaptSymToAlgoOrderStatus(valStr)
+		case "side":
+			side = valStr
+		case "posSide":
+			posSide = valStr
+		case "reduceOnly":
+			reduceOnly = valStr == "true"
+		case "sz":
+			ord.Qty = cast.ToFloat64(valStr)
+		case "triggerPx":
+			ord.TriggerPx = cast.ToFloat64(valStr)
+		case "ordPx":
+			ord.OrderPx = cast.ToFloat64(valStr)
+		case "tpTriggerPx":
+			ord.TpTriggerPx = cast.ToFloat64(valStr)
+		case "tpOrdPx":
+			ord.TpOrderPx = cast.ToFloat64(valStr)
+		case "slTriggerPx":
+			ord.SlTriggerPx = cast.ToFloat64(valStr)
+		case "slOrdPx":
+			ord.SlOrderPx = cast.ToFloat64(valStr)
+		case "callbackRatio":
+			ord.CallbackRatio = cast.ToFloat64(valStr)
+		case "callbackSpread":
+			ord.CallbackSpread = cast.ToFloat64(valStr)
+		case "activePx":
+			ord.ActivePx = cast.ToFloat64(valStr)
+		case "actualPx":
+			ord.ActualPx = cast.ToFloat64(valStr)
+		case "actualSz":
+			ord.ActualQty = cast.ToFloat64(valStr)
+		case "triggerTime":
+			ord.TriggeredAt = cast.ToInt64(valStr)
+		case "cTime":
+			ord.CreatedAt = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+
+	ord.Side = adaptSymToOrderSide(side, posSide, reduceOnly)
+
+	return ord, err
+}
+
+func (un *RespUnmarshaler) UnmarshalCancelAlgoOrdersResponse(data []byte) error {
+	var errMsgs []string
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		sCode, _ := jsonparser.GetString(value, "sCode")
+		if sCode == "" || sCode == "0" {
+			return
+		}
+		algoId, _ := jsonparser.GetString(value, "algoId")
+		sMsg, _ := jsonparser.GetString(value, "sMsg")
+		errMsgs = append(errMsgs, fmt.Sprintf("%s: %s", algoId, sMsg))
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(errMsgs) > 0 {
+		return errors.New(strings.Join(errMsgs, "; "))
+	}
+
+	return nil
+}
+
 func (un *RespUnmarshaler) UnmarshalResponse(data []byte, res interface{}) error {
 	return json.Unmarshal(data, res)
 }
@@ -0,0 +1,80 @@
+package common
+
+import (
+	. "github.com/nntaoli-project/goex/v2/model"
+	"testing"
+)
+
+func TestRespUnmarshaler_UnmarshalGetPositionModeResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    PositionMode
+		wantErr bool
+	}{
+		{name: "hedge", data: `[{"posMode":"long_short_mode","acctLv":"2"}]`, want: PositionMode_Hedge},
+		{name: "one-way", data: `[{"posMode":"net_mode","acctLv":"2"}]`, want: PositionMode_OneWay},
+		{name: "unknown", data: `[{"posMode":"other"}]`, wantErr: true},
+		{name: "missing", data: `[{"acctLv":"2"}]`, wantErr: true},
+		{name: "empty", data: `[]`, wantErr: true},
+	}
+
+	un := new(RespUnmarshaler)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, err := un.UnmarshalGetPositionModeResponse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mode != tt.want {
+				t.Fatalf("mode = %s, want %s", mode, tt.want)
+			}
+		})
+	}
+}
+
+func TestRespUnmarshaler_UnmarshalGetTradeFeeResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      string
+		wantMaker float64
+		wantTaker float64
+		wantErr   bool
+	}{
+		{
+			name:      "spot",
+			data:      `[{"instType":"SPOT","maker":"-0.0008","taker":"-0.001","makerU":"","takerU":""}]`,
+			wantMaker: 0.0008,
+			wantTaker: 0.001,
+		},
+		{
+			name:      "usdt swap uses makerU/takerU",
+			data:      `[{"instType":"SWAP","maker":"-0.0001","taker":"-0.0003","makerU":"-0.0002","takerU":"-0.0005"}]`,
+			wantMaker: 0.0002,
+			wantTaker: 0.0005,
+		},
+		{
+			name:      "maker rebate",
+			data:      `[{"instType":"SPOT","maker":"0.00005","taker":"-0.0007"}]`,
+			wantMaker: -0.00005,
+			wantTaker: 0.0007,
+		},
+		{name: "empty", data: `[]`, wantErr: true},
+	}
+
+	un := new(RespUnmarshaler)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fee, err := un.UnmarshalGetTradeFeeResponse([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if fee.Maker != tt.wantMaker || fee.Taker != tt.wantTaker {
+				t.Fatalf("fee = (%v, %v), want (%v, %v)", fee.Maker, fee.Taker, tt.wantMaker, tt.wantTaker)
+			}
+		})
+	}
+}
@@ -2,12 +2,14 @@ package common
 
 import (
 	"encoding/json"
+	"github.com/nntaoli-project/goex/v2/httpcli"
 	. "github.com/nntaoli-project/goex/v2/options"
 )
 
 type OKxV5 struct {
 	UriOpts       UriOptions
 	UnmarshalOpts UnmarshalerOptions
+	ExOpts        ExchangeOptions
 }
 
 type BaseResp struct {
@@ -16,40 +18,116 @@ type BaseResp struct {
 	Data json.RawMessage `json:"data"`
 }
 
-func New() *OKxV5 {
+// New 模拟盘(Env_Demo/Env_Testnet)与生产环境使用相同的域名,请求时带上x-simulated-trading请求头
+func New(opts ...ExchangeOption) *OKxV5 {
 	unmarshaler := new(RespUnmarshaler)
 
 	f := &OKxV5{
+		ExOpts: NewExchangeOptions(opts...),
 		UriOpts: UriOptions{
-			Endpoint:            "https://www.okx.com",
-			KlineUri:            "/api/v5/market/candles",
-			TickerUri:           "/api/v5/market/ticker",
-			DepthUri:            "/api/v5/market/books",
-			NewOrderUri:         "/api/v5/trade/order",
-			GetOrderUri:         "/api/v5/trade/order",
-			GetHistoryOrdersUri: "/api/v5/trade/orders-history",
-			GetPendingOrdersUri: "/api/v5/trade/orders-pending",
-			CancelOrderUri:      "/api/v5/trade/cancel-order",
-			GetAccountUri:       "/api/v5/account/balance",
-			GetPositionsUri:     "/api/v5/account/positions",
-			GetExchangeInfoUri:  "/api/v5/public/instruments",
+			Endpoint:                  "https://www.okx.com",
+			KlineUri:                  "/api/v5/market/candles",
+			TickerUri:                 "/api/v5/market/ticker",
+			DepthUri:                  "/api/v5/market/books",
+			NewOrderUri:               "/api/v5/trade/order",
+			GetOrderUri:               "/api/v5/trade/order",
+			GetHistoryOrdersUri:       "/api/v5/trade/orders-history",
+			GetPendingOrdersUri:       "/api/v5/trade/orders-pending",
+			CancelOrderUri:            "/api/v5/trade/cancel-order",
+			GetAccountUri:             "/api/v5/account/balance",
+			GetPositionsUri:           "/api/v5/account/positions",
+			GetExchangeInfoUri:        "/api/v5/public/instruments",
+			GetOptionSummaryUri:       "/api/v5/public/opt-summary",
+			NewAlgoOrderUri:           "/api/v5/trade/order-algo",
+			CancelAlgoOrdersUri:       "/api/v5/trade/cancel-algos",
+			GetPendingAlgoOrdersUri:   "/api/v5/trade/orders-algo-pending",
+			GetHistoryAlgoOrdersUri:   "/api/v5/trade/orders-algo-history",
+			NewBatchOrdersUri:         "/api/v5/trade/batch-orders",
+			CancelBatchOrdersUri:      "/api/v5/trade/cancel-batch-orders",
+			AmendOrderUri:             "/api/v5/trade/amend-order",
+			CancelAllAfterUri:         "/api/v5/trade/cancel-all-after",
+			GetFillsUri:               "/api/v5/trade/fills",
+			GetFillsHistoryUri:        "/api/v5/trade/fills-history",
+			GetTradesUri:              "/api/v5/market/trades",
+			GetFundingRateUri:         "/api/v5/public/funding-rate",
+			GetFundingRateHistoryUri:  "/api/v5/public/funding-rate-history",
+			GetMarkPriceUri:           "/api/v5/public/mark-price",
+			GetIndexPriceUri:          "/api/v5/market/index-tickers",
+			GetOpenInterestHistoryUri: "/api/v5/rubik/stat/contracts/open-interest-volume",
+			GetLongShortRatioUri:      "/api/v5/rubik/stat/contracts/long-short-account-ratio",
+			GetTakerVolumeUri:         "/api/v5/rubik/stat/taker-volume",
+			SetLeverageUri:            "/api/v5/account/set-leverage",
+			GetLeverageUri:            "/api/v5/account/leverage-info",
+			SetPositionModeUri:        "/api/v5/account/set-position-mode",
+			TransferUri:               "/api/v5/asset/transfer",
+			GetTransferHistoryUri:     "/api/v5/asset/bills",
+			GetCoinNetworksUri:        "/api/v5/asset/currencies",
+			GetDepositAddressUri:      "/api/v5/asset/deposit-address",
+			GetDepositHistoryUri:      "/api/v5/asset/deposit-history",
+			GetWithdrawHistoryUri:     "/api/v5/asset/withdrawal-history",
+			WithdrawUri:               "/api/v5/asset/withdrawal",
+			CancelWithdrawUri:         "/api/v5/asset/cancel-withdrawal",
+			GetSubAccountsUri:         "/api/v5/users/subaccount/list",
+			GetSubAccountBalanceUri:   "/api/v5/account/subaccount/balances",
+			SubAccountTransferUri:     "/api/v5/asset/subaccount/transfer",
+			CreateSubAccountApiKeyUri: "/api/v5/users/subaccount/apikey",
+			GetBillsUri:               "/api/v5/account/bills",
+			GetBillsArchiveUri:        "/api/v5/account/bills-archive",
+			GetTradeFeeUri:            "/api/v5/account/trade-fee",
+			GetServerTimeUri:          "/api/v5/public/time",
+			GetPositionModeUri:        "/api/v5/account/config",
+			GetUnderlyingUri:          "/api/v5/public/underlying",
 		},
 		UnmarshalOpts: UnmarshalerOptions{
-			ResponseUnmarshaler:                  unmarshaler.UnmarshalResponse,
-			KlineUnmarshaler:                     unmarshaler.UnmarshalGetKlineResponse,
-			TickerUnmarshaler:                    unmarshaler.UnmarshalTicker,
-			DepthUnmarshaler:                     unmarshaler.UnmarshalDepth,
-			CreateOrderResponseUnmarshaler:       unmarshaler.UnmarshalCreateOrderResponse,
-			GetPendingOrdersResponseUnmarshaler:  unmarshaler.UnmarshalGetPendingOrdersResponse,
-			GetHistoryOrdersResponseUnmarshaler:  unmarshaler.UnmarshalGetHistoryOrdersResponse,
-			CancelOrderResponseUnmarshaler:       unmarshaler.UnmarshalCancelOrderResponse,
-			GetOrderInfoResponseUnmarshaler:      unmarshaler.UnmarshalGetOrderInfoResponse,
-			GetAccountResponseUnmarshaler:        unmarshaler.UnmarshalGetAccountResponse,
-			GetPositionsResponseUnmarshaler:      unmarshaler.UnmarshalGetPositionsResponse,
-			GetFuturesAccountResponseUnmarshaler: unmarshaler.UnmarshalGetFuturesAccountResponse,
-			GetExchangeInfoResponseUnmarshaler:   unmarshaler.UnmarshalGetExchangeInfoResponse,
+			ResponseUnmarshaler:                       unmarshaler.UnmarshalResponse,
+			KlineUnmarshaler:                          unmarshaler.UnmarshalGetKlineResponse,
+			TickerUnmarshaler:                         unmarshaler.UnmarshalTicker,
+			DepthUnmarshaler:                          unmarshaler.UnmarshalDepth,
+			CreateOrderResponseUnmarshaler:            unmarshaler.UnmarshalCreateOrderResponse,
+			GetPendingOrdersResponseUnmarshaler:       unmarshaler.UnmarshalGetPendingOrdersResponse,
+			GetHistoryOrdersResponseUnmarshaler:       unmarshaler.UnmarshalGetHistoryOrdersResponse,
+			CancelOrderResponseUnmarshaler:            unmarshaler.UnmarshalCancelOrderResponse,
+			GetOrderInfoResponseUnmarshaler:           unmarshaler.UnmarshalGetOrderInfoResponse,
+			GetAccountResponseUnmarshaler:             unmarshaler.UnmarshalGetAccountResponse,
+			GetPositionsResponseUnmarshaler:           unmarshaler.UnmarshalGetPositionsResponse,
+			GetFuturesAccountResponseUnmarshaler:      unmarshaler.UnmarshalGetFuturesAccountResponse,
+			GetExchangeInfoResponseUnmarshaler:        unmarshaler.UnmarshalGetExchangeInfoResponse,
+			GetOptionSummaryResponseUnmarshaler:       unmarshaler.UnmarshalGetOptionSummaryResponse,
+			CreateAlgoOrderResponseUnmarshaler:        unmarshaler.UnmarshalCreateAlgoOrderResponse,
+			GetPendingAlgoOrdersResponseUnmarshaler:   unmarshaler.UnmarshalGetPendingAlgoOrdersResponse,
+			GetHistoryAlgoOrdersResponseUnmarshaler:   unmarshaler.UnmarshalGetHistoryAlgoOrdersResponse,
+			CancelAlgoOrdersResponseUnmarshaler:       unmarshaler.UnmarshalCancelAlgoOrdersResponse,
+			CreateOrdersResponseUnmarshaler:           unmarshaler.UnmarshalCreateOrdersResponse,
+			CancelOrdersResponseUnmarshaler:           unmarshaler.UnmarshalCancelOrdersResponse,
+			AmendOrderResponseUnmarshaler:             unmarshaler.UnmarshalAmendOrderResponse,
+			GetFillsResponseUnmarshaler:               unmarshaler.UnmarshalGetFillsResponse,
+			GetTradesResponseUnmarshaler:              unmarshaler.UnmarshalGetTradesResponse,
+			GetFundingRateResponseUnmarshaler:         unmarshaler.UnmarshalGetFundingRateResponse,
+			GetFundingRateHistoryResponseUnmarshaler:  unmarshaler.UnmarshalGetFundingRateHistoryResponse,
+			GetMarkPriceResponseUnmarshaler:           unmarshaler.UnmarshalGetMarkPriceResponse,
+			GetIndexPriceResponseUnmarshaler:          unmarshaler.UnmarshalGetIndexPriceResponse,
+			GetOpenInterestHistoryResponseUnmarshaler: unmarshaler.UnmarshalGetOpenInterestHistoryResponse,
+			GetLongShortRatioResponseUnmarshaler:      unmarshaler.UnmarshalGetLongShortRatioResponse,
+			GetTakerVolumeResponseUnmarshaler:         unmarshaler.UnmarshalGetTakerVolumeResponse,
+			GetLeverageResponseUnmarshaler:            unmarshaler.UnmarshalGetLeverageResponse,
+			TransferResponseUnmarshaler:               unmarshaler.UnmarshalTransferResponse,
+			GetTransferHistoryResponseUnmarshaler:     unmarshaler.UnmarshalGetTransferHistoryResponse,
+			GetCoinNetworksResponseUnmarshaler:        unmarshaler.UnmarshalGetCoinNetworksResponse,
+			GetDepositAddressResponseUnmarshaler:      unmarshaler.UnmarshalGetDepositAddressResponse,
+			GetDepositHistoryResponseUnmarshaler:      unmarshaler.UnmarshalGetDepositHistoryResponse,
+			GetWithdrawHistoryResponseUnmarshaler:     unmarshaler.UnmarshalGetWithdrawHistoryResponse,
+			WithdrawResponseUnmarshaler:               unmarshaler.UnmarshalWithdrawResponse,
+			GetSubAccountsResponseUnmarshaler:         unmarshaler.UnmarshalGetSubAccountsResponse,
+			CreateSubAccountApiKeyResponseUnmarshaler: unmarshaler.UnmarshalCreateSubAccountApiKeyResponse,
+			GetBillsResponseUnmarshaler:               unmarshaler.UnmarshalGetBillsResponse,
+			GetTradeFeeResponseUnmarshaler:            unmarshaler.UnmarshalGetTradeFeeResponse,
+			GetServerTimeResponseUnmarshaler:          unmarshaler.UnmarshalGetServerTimeResponse,
+			GetPositionModeResponseUnmarshaler:        unmarshaler.UnmarshalGetPositionModeResponse,
 		},
 	}
+	//aws.okx.com为官方的AWS线路,模拟盘同样可用
+	f.UriOpts.EndpointPool = httpcli.NewEndpointPool(f.ExOpts.Logger, f.UriOpts.GetServerTimeUri, f.UriOpts.Endpoint, "https://aws.okx.com")
+	f.UriOpts.EndpointPool.Start(f.ExOpts.EndpointProbeInterval)
 
 	return f
 }
@@ -68,6 +146,18 @@ func (okx *OKxV5) WithUnmarshalOption(opts ...UnmarshalerOption) *OKxV5 {
 	return okx
 }
 
+// simulatedHeader 模拟盘请求需要的请求头
+func (okx *OKxV5) simulatedHeader(headers map[string]string) map[string]string {
+	if !okx.ExOpts.IsSandbox() {
+		return headers
+	}
+	if headers == nil {
+		headers = make(map[string]string, 1)
+	}
+	headers["x-simulated-trading"] = "1"
+	return headers
+}
+
 func (okx *OKxV5) NewPrvApi(opts ...ApiOption) *Prv {
 	api := NewPrvApi(opts...)
 	api.OKxV5 = okx
@@ -0,0 +1,140 @@
+package common
+
+import (
+	"fmt"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+func (prv *Prv) GetCoinNetworks(coin string, opts ...model.OptionParameter) ([]model.CoinNetwork, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetCoinNetworksUri)
+	params := url.Values{}
+	if coin != "" {
+		params.Set("ccy", coin)
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	networks, err := prv.UnmarshalOpts.GetCoinNetworksResponseUnmarshaler(data)
+	return networks, responseBody, err
+}
+
+func (prv *Prv) GetDepositAddress(coin, network string, opts ...model.OptionParameter) ([]model.DepositAddress, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetDepositAddressUri)
+	params := url.Values{}
+	params.Set("ccy", coin)
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	addresses, err := prv.UnmarshalOpts.GetDepositAddressResponseUnmarshaler(data)
+	if err != nil || network == "" {
+		return addresses, responseBody, err
+	}
+
+	filtered := make([]model.DepositAddress, 0, 1)
+	for _, addr := range addresses {
+		if addr.Network == network {
+			filtered = append(filtered, addr)
+		}
+	}
+
+	return filtered, responseBody, nil
+}
+
+func (prv *Prv) GetDepositHistory(coin string, opts ...model.OptionParameter) ([]model.WalletRecord, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetDepositHistoryUri)
+	params := url.Values{}
+	if coin != "" {
+		params.Set("ccy", coin)
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	records, err := prv.UnmarshalOpts.GetDepositHistoryResponseUnmarshaler(data)
+	return records, responseBody, err
+}
+
+func (prv *Prv) GetWithdrawHistory(coin string, opts ...model.OptionParameter) ([]model.WalletRecord, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.GetWithdrawHistoryUri)
+	params := url.Values{}
+	if coin != "" {
+		params.Set("ccy", coin)
+	}
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	records, err := prv.UnmarshalOpts.GetWithdrawHistoryResponseUnmarshaler(data)
+	return records, responseBody, err
+}
+
+// Withdraw 链上提币(dest=4),需要传入手续费,可以通过GetCoinNetworks获取
+// 必须通过options.WithWithdrawGuard设置安全检查
+func (prv *Prv) Withdraw(req model.WithdrawRequest, opts ...model.OptionParameter) (string, []byte, error) {
+	return prv.apiOpts.WithdrawGuard.Withdraw(req, func() (string, []byte, error) {
+		return prv.withdraw(req, opts...)
+	})
+}
+
+func (prv *Prv) withdraw(req model.WithdrawRequest, opts ...model.OptionParameter) (string, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.WithdrawUri)
+	toAddr := req.Address
+	if req.Tag != "" {
+		toAddr = fmt.Sprintf("%s:%s", req.Address, req.Tag)
+	}
+
+	params := url.Values{}
+	params.Set("ccy", req.Coin)
+	params.Set("chain", req.Network)
+	params.Set("amt", util.FloatToString(req.Amount, 8))
+	params.Set("fee", util.FloatToString(req.Fee, 8))
+	params.Set("dest", "4")
+	params.Set("toAddr", toAddr)
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		//返回了非0的code说明交易所没有受理
+		if code, _ := jsonparser.GetString(responseBody, "code"); code != "" && code != "0" {
+			err = options.WithdrawRejected(err)
+		}
+		return "", responseBody, err
+	}
+
+	id, err := prv.UnmarshalOpts.WithdrawResponseUnmarshaler(data)
+	return id, responseBody, err
+}
+
+func (prv *Prv) CancelWithdraw(id string, opts ...model.OptionParameter) ([]byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.UriOpts.Endpoint, prv.UriOpts.CancelWithdrawUri)
+	params := url.Values{}
+	params.Set("wdId", id)
+	util.MergeOptionParams(&params, opts...)
+
+	_, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		return responseBody, err
+	}
+
+	prv.apiOpts.WithdrawGuard.Refund(id)
+	return responseBody, nil
+}
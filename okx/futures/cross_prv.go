@@ -25,3 +25,39 @@ func (f *CrossPrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side Or
 
 	return f.Prv.CreateOrder(pair, qty, price, side, orderTy, opts...)
 }
+
+func (f *CrossPrvApi) CreateOrders(orders []Order, opts ...OptionParameter) ([]BatchOrderResult, []byte, error) {
+	for _, ord := range orders {
+		if ord.Side != Futures_OpenBuy &&
+			ord.Side != Futures_OpenSell &&
+			ord.Side != Futures_CloseBuy &&
+			ord.Side != Futures_CloseSell {
+			return nil, nil, errors.New("futures side only is Futures_OpenBuy or Futures_OpenSell or Futures_CloseBuy or Futures_CloseSell")
+		}
+	}
+
+	opts = append(opts,
+		OptionParameter{
+			Key:   "tdMode",
+			Value: "cross",
+		})
+
+	return f.Prv.CreateOrders(orders, opts...)
+}
+
+func (f *CrossPrvApi) CreateAlgoOrder(pair CurrencyPair, qty float64, side OrderSide, algoTy AlgoOrderType, trigger AlgoTrigger, opts ...OptionParameter) (*AlgoOrder, []byte, error) {
+	if side != Futures_OpenBuy &&
+		side != Futures_OpenSell &&
+		side != Futures_CloseBuy &&
+		side != Futures_CloseSell {
+		return nil, nil, errors.New("futures side only is Futures_OpenBuy or Futures_OpenSell or Futures_CloseBuy or Futures_CloseSell")
+	}
+
+	opts = append(opts,
+		OptionParameter{
+			Key:   "tdMode",
+			Value: "cross",
+		})
+
+	return f.Prv.CreateAlgoOrder(pair, qty, side, algoTy, trigger, opts...)
+}
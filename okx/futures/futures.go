@@ -12,9 +12,9 @@ type Futures struct {
 	currencyPairM map[string]model.CurrencyPair
 }
 
-func New() *Futures {
+func New(opts ...options.ExchangeOption) *Futures {
 	currencyPairM := make(map[string]model.CurrencyPair, 64)
-	return &Futures{OKxV5: common.New(), currencyPairM: currencyPairM}
+	return &Futures{OKxV5: common.New(opts...), currencyPairM: currencyPairM}
 }
 
 func (f *Futures) NewPrvApi(apiOpts ...options.ApiOption) *PrvApi {
@@ -38,3 +38,8 @@ func (f *Futures) NewCurrencyPair(baseSym, quoteSym string, opts ...model.Option
 	}
 	return model.CurrencyPair{}, errors.New("please input contract alias option parameter")
 }
+
+func (f *Futures) GetMarkPrice(pair model.CurrencyPair, opts ...model.OptionParameter) (*model.MarkPrice, []byte, error) {
+	opts = append([]model.OptionParameter{{Key: "instType", Value: "FUTURES"}}, opts...)
+	return f.OKxV5.GetMarkPrice(pair, opts...)
+}
@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"errors"
 	"fmt"
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/okx/common"
@@ -12,13 +13,15 @@ import (
 
 type PrvApi struct {
 	*common.Prv
-	Isolated *IsolatedPrvApi
-	Cross    *CrossPrvApi
+	Isolated   *IsolatedPrvApi
+	Cross      *CrossPrvApi
+	marginMode model.MarginMode
 }
 
 func NewPrvApi(v5 *common.OKxV5, apiOpts ...options.ApiOption) *PrvApi {
 	prvApi := new(PrvApi)
 	prvApi.Prv = v5.NewPrvApi(apiOpts...)
+	prvApi.marginMode = model.MarginMode_Cross
 
 	prvApi.Isolated = new(IsolatedPrvApi)
 	prvApi.Isolated.PrvApi = prvApi
@@ -61,3 +64,58 @@ func (prv *PrvApi) GetHistoryOrders(pair model.CurrencyPair, opt ...model.Option
 	})
 	return prv.Prv.GetHistoryOrders(pair, opt...)
 }
+
+func (prv *PrvApi) GetFillsHistory(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Trade, []byte, error) {
+	opt = append(opt, model.OptionParameter{
+		Key:   "instType",
+		Value: "SWAP",
+	})
+	return prv.Prv.GetFillsHistory(pair, opt...)
+}
+
+func (prv *PrvApi) GetTradeFee(pair model.CurrencyPair, opt ...model.OptionParameter) (*model.TradeFee, []byte, error) {
+	opt = append(opt, model.OptionParameter{
+		Key:   "instType",
+		Value: "SWAP",
+	})
+	return prv.Prv.GetTradeFee(pair, opt...)
+}
+
+// SetLeverage 按SetMarginMode设置的保证金模式(默认全仓)设置杠杆倍数,逐仓双向持仓模式需要通过opt传入posSide
+func (prv *PrvApi) SetLeverage(pair model.CurrencyPair, lever float64, opts ...model.OptionParameter) ([]byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.OKxV5.UriOpts.Endpoint, prv.OKxV5.UriOpts.SetLeverageUri)
+	params := url.Values{}
+	params.Set("instId", pair.Symbol)
+	params.Set("lever", util.FloatToString(lever, 2))
+	params.Set("mgnMode", string(prv.marginMode))
+	util.MergeOptionParams(&params, opts...)
+
+	_, responseBody, err := prv.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	return responseBody, err
+}
+
+func (prv *PrvApi) GetLeverage(pair model.CurrencyPair, opts ...model.OptionParameter) (float64, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", prv.OKxV5.UriOpts.Endpoint, prv.OKxV5.UriOpts.GetLeverageUri)
+	params := url.Values{}
+	params.Set("instId", pair.Symbol)
+	params.Set("mgnMode", string(prv.marginMode))
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := prv.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return 0, responseBody, err
+	}
+
+	lever, err := prv.OKxV5.UnmarshalOpts.GetLeverageResponseUnmarshaler(data)
+	return lever, responseBody, err
+}
+
+// SetMarginMode okx的保证金模式在下单时通过tdMode指定(见Cross/Isolated),这里不发送请求,
+// 只修改本地SetLeverage/GetLeverage使用的mgnMode,返回的responseBody为nil
+func (prv *PrvApi) SetMarginMode(pair model.CurrencyPair, mode model.MarginMode, opts ...model.OptionParameter) ([]byte, error) {
+	if mode != model.MarginMode_Cross && mode != model.MarginMode_Isolated {
+		return nil, errors.New("margin mode only is MarginMode_Cross or MarginMode_Isolated")
+	}
+	prv.marginMode = mode
+	return nil, nil
+}
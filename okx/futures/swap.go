@@ -12,10 +12,10 @@ type Swap struct {
 	currencyPairM map[string]model.CurrencyPair
 }
 
-func NewSwap() *Swap {
+func NewSwap(opts ...options.ExchangeOption) *Swap {
 	var currencyPairM = make(map[string]model.CurrencyPair, 64)
 	return &Swap{
-		OKxV5:         common.New(),
+		OKxV5:         common.New(opts...),
 		currencyPairM: currencyPairM}
 }
 
@@ -33,6 +33,11 @@ func (f *Swap) NewCurrencyPair(baseSym, quoteSym string, opts ...model.OptionPar
 	return currencyPair, nil
 }
 
+func (f *Swap) GetMarkPrice(pair model.CurrencyPair, opts ...model.OptionParameter) (*model.MarkPrice, []byte, error) {
+	opts = append([]model.OptionParameter{{Key: "instType", Value: "SWAP"}}, opts...)
+	return f.OKxV5.GetMarkPrice(pair, opts...)
+}
+
 func (f *Swap) NewPrvApi(apiOpts ...options.ApiOption) *PrvApi {
 	return NewPrvApi(f.OKxV5, apiOpts...)
 }
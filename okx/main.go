@@ -2,19 +2,23 @@ package okx
 
 import (
 	"github.com/nntaoli-project/goex/v2/okx/futures"
+	"github.com/nntaoli-project/goex/v2/okx/option"
 	"github.com/nntaoli-project/goex/v2/okx/spot"
+	"github.com/nntaoli-project/goex/v2/options"
 )
 
 type OKx struct {
 	Spot    *spot.Spot
 	Futures *futures.Futures
 	Swap    *futures.Swap
+	Option  *option.Option
 }
 
-func New() *OKx {
+func New(opts ...options.ExchangeOption) *OKx {
 	return &OKx{
-		Spot:    spot.New(),
-		Futures: futures.New(),
-		Swap:    futures.NewSwap(),
+		Spot:    spot.New(opts...),
+		Futures: futures.New(opts...),
+		Swap:    futures.NewSwap(opts...),
+		Option:  option.New(opts...),
 	}
 }
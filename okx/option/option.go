@@ -0,0 +1,23 @@
+package option
+
+import (
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/okx/common"
+	"github.com/nntaoli-project/goex/v2/options"
+)
+
+type Option struct {
+	*common.OKxV5
+	currencyPairM map[string]CurrencyPair
+}
+
+func New(opts ...options.ExchangeOption) *Option {
+	currencyPairM := make(map[string]CurrencyPair, 256)
+	return &Option{OKxV5: common.New(opts...), currencyPairM: currencyPairM}
+}
+
+func (o *Option) NewPrvApi(apiOpts ...options.ApiOption) *PrvApi {
+	prv := new(PrvApi)
+	prv.Prv = o.OKxV5.NewPrvApi(apiOpts...)
+	return prv
+}
@@ -0,0 +1,56 @@
+package option
+
+import (
+	"errors"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/okx/common"
+)
+
+type PrvApi struct {
+	*common.Prv
+}
+
+// CreateOrder 期权下单,默认全仓(tdMode=cross),可通过opts传入tdMode=isolated覆盖
+func (api *PrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side OrderSide, orderTy OrderType, opts ...OptionParameter) (*Order, []byte, error) {
+	if Spot_Buy != side && side != Spot_Sell {
+		return nil, nil, errors.New("option order side only is Spot_Buy or Spot_Sell")
+	}
+
+	opts = append([]OptionParameter{{
+		Key:   "tdMode",
+		Value: "cross",
+	}}, opts...)
+
+	return api.Prv.CreateOrder(pair, qty, price, side, orderTy, opts...)
+}
+
+func (api *PrvApi) CreateOrders(orders []Order, opts ...OptionParameter) ([]BatchOrderResult, []byte, error) {
+	for _, ord := range orders {
+		if Spot_Buy != ord.Side && ord.Side != Spot_Sell {
+			return nil, nil, errors.New("option order side only is Spot_Buy or Spot_Sell")
+		}
+	}
+
+	opts = append([]OptionParameter{{
+		Key:   "tdMode",
+		Value: "cross",
+	}}, opts...)
+
+	return api.Prv.CreateOrders(orders, opts...)
+}
+
+func (api *PrvApi) GetHistoryOrders(pair CurrencyPair, opt ...OptionParameter) ([]Order, []byte, error) {
+	opt = append(opt, OptionParameter{
+		Key:   "instType",
+		Value: "OPTION",
+	})
+	return api.Prv.GetHistoryOrders(pair, opt...)
+}
+
+func (api *PrvApi) GetFillsHistory(pair CurrencyPair, opt ...OptionParameter) ([]Trade, []byte, error) {
+	opt = append(opt, OptionParameter{
+		Key:   "instType",
+		Value: "OPTION",
+	})
+	return api.Prv.GetFillsHistory(pair, opt...)
+}
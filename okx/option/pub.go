@@ -0,0 +1,103 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// GetExchangeInfo 查询全部期权标的后逐个调用GetExchangeInfoByUly加载,返回全部标的的期权合约,
+// responseBody为最后一个标的的响应
+func (o *Option) GetExchangeInfo() (map[string]model.CurrencyPair, []byte, error) {
+	underlyings, responseBody, err := o.OKxV5.GetUnderlyings("OPTION")
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	all := make(map[string]model.CurrencyPair, 256)
+	for _, uly := range underlyings {
+		m, b, er := o.GetExchangeInfoByUly(uly)
+		if er != nil {
+			return nil, b, er
+		}
+		for instId, pair := range m {
+			all[instId] = pair
+		}
+		responseBody = b
+	}
+
+	return all, responseBody, nil
+}
+
+// GetExchangeInfoByUly 加载一个标的(如 BTC-USD)的期权合约,合并到已加载的合约中
+func (o *Option) GetExchangeInfoByUly(uly string, opts ...model.OptionParameter) (map[string]model.CurrencyPair, []byte, error) {
+	if uly == "" {
+		return nil, nil, errors.New("option underlying is required")
+	}
+
+	m, b, er := o.OKxV5.GetExchangeInfo("OPTION", append([]model.OptionParameter{{Key: "uly", Value: uly}}, opts...)...)
+	if er != nil {
+		return nil, b, er
+	}
+	for instId, pair := range m {
+		o.currencyPairM[instId] = pair
+	}
+	return m, b, er
+}
+
+// NewCurrencyPair 期权合约需要传入到期日、行权价、期权类型
+// @parameter
+//   - opts expiry: 到期日,如 230630; strike: 行权价,如 30000; optType: C / P
+func (o *Option) NewCurrencyPair(baseSym, quoteSym string, opts ...model.OptionParameter) (model.CurrencyPair, error) {
+	var expiry, strike, optType string
+	for _, opt := range opts {
+		switch opt.Key {
+		case "expiry":
+			expiry = opt.Value
+		case "strike":
+			strike = opt.Value
+		case "optType":
+			optType = opt.Value
+		}
+	}
+
+	if expiry == "" || strike == "" || optType == "" {
+		return model.CurrencyPair{}, errors.New("please input expiry, strike and optType option parameter")
+	}
+
+	instId := fmt.Sprintf("%s-%s-%s-%s-%s", baseSym, quoteSym, expiry, strike, optType)
+	currencyPair := o.currencyPairM[instId]
+	if currencyPair.Symbol == "" {
+		return currencyPair, errors.New("not found currency pair")
+	}
+	return currencyPair, nil
+}
+
+// GetOptionSummary 获取标的下所有期权合约的希腊字母及标记波动率
+func (o *Option) GetOptionSummary(baseSym, quoteSym string, opts ...model.OptionParameter) ([]model.OptionSummary, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", o.UriOpts.Endpoint, o.UriOpts.GetOptionSummaryUri)
+	params := url.Values{}
+	params.Set("uly", fmt.Sprintf("%s-%s", baseSym, quoteSym))
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := o.DoNoAuthRequest(http.MethodGet, reqUrl, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	summaries, err := o.UnmarshalOpts.GetOptionSummaryResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	for i := range summaries {
+		if pair, ok := o.currencyPairM[summaries[i].Pair.Symbol]; ok {
+			summaries[i].Pair = pair
+		}
+	}
+
+	return summaries, responseBody, nil
+}
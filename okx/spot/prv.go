@@ -25,6 +25,36 @@ func (api *PrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side Order
 	return api.Prv.CreateOrder(pair, qty, price, side, orderTy, opts...)
 }
 
+func (api *PrvApi) CreateOrders(orders []Order, opts ...OptionParameter) ([]BatchOrderResult, []byte, error) {
+	for _, ord := range orders {
+		if Spot_Buy != ord.Side && ord.Side != Spot_Sell {
+			return nil, nil, errors.New("spot order side is error")
+		}
+	}
+
+	opts = append(opts,
+		OptionParameter{
+			Key:   "tdMode",
+			Value: "cash",
+		})
+
+	return api.Prv.CreateOrders(orders, opts...)
+}
+
+func (api *PrvApi) CreateAlgoOrder(pair CurrencyPair, qty float64, side OrderSide, algoTy AlgoOrderType, trigger AlgoTrigger, opts ...OptionParameter) (*AlgoOrder, []byte, error) {
+	if Spot_Buy != side && side != Spot_Sell {
+		return nil, nil, errors.New("spot order side is error")
+	}
+
+	opts = append(opts,
+		OptionParameter{
+			Key:   "tdMode",
+			Value: "cash",
+		})
+
+	return api.Prv.CreateAlgoOrder(pair, qty, side, algoTy, trigger, opts...)
+}
+
 func (api *PrvApi) GetHistoryOrders(pair CurrencyPair, opt ...OptionParameter) ([]Order, []byte, error) {
 	opt = append(opt, OptionParameter{
 		Key:   "instType",
@@ -32,3 +62,19 @@ func (api *PrvApi) GetHistoryOrders(pair CurrencyPair, opt ...OptionParameter) (
 	})
 	return api.Prv.GetHistoryOrders(pair, opt...)
 }
+
+func (api *PrvApi) GetFillsHistory(pair CurrencyPair, opt ...OptionParameter) ([]Trade, []byte, error) {
+	opt = append(opt, OptionParameter{
+		Key:   "instType",
+		Value: "SPOT",
+	})
+	return api.Prv.GetFillsHistory(pair, opt...)
+}
+
+func (api *PrvApi) GetTradeFee(pair CurrencyPair, opt ...OptionParameter) (*TradeFee, []byte, error) {
+	opt = append(opt, OptionParameter{
+		Key:   "instType",
+		Value: "SPOT",
+	})
+	return api.Prv.GetTradeFee(pair, opt...)
+}
@@ -11,8 +11,8 @@ type Spot struct {
 	currencyPairM map[string]CurrencyPair
 }
 
-func New() *Spot {
-	v5 := common.New()
+func New(opts ...options.ExchangeOption) *Spot {
+	v5 := common.New(opts...)
 	currencyPairCacheMap := make(map[string]CurrencyPair, 64)
 	return &Spot{v5, currencyPairCacheMap}
 }
@@ -1,10 +1,38 @@
 package options
 
+import (
+	"github.com/nntaoli-project/goex/v2/model"
+	"time"
+)
+
+// Clock 签名使用的时钟,可以使用clock.Service修正本地时间与交易所服务器的时间偏差
+type Clock interface {
+	Now() time.Time
+}
+
+// SignMethod API签名算法,RSA和Ed25519的Secret为PEM格式的私钥
+type SignMethod string
+
+const (
+	SignMethod_HmacSHA256 SignMethod = "HMAC_SHA256"
+	SignMethod_RSA        SignMethod = "RSA"
+	SignMethod_Ed25519    SignMethod = "ED25519"
+)
+
 type ApiOptions struct {
 	Key        string
 	Secret     string
 	Passphrase string
 	ClientId   string
+	Clock      Clock
+	SignMethod SignMethod //为空时使用HMAC_SHA256,目前只有binance支持RSA和Ed25519
+	RecvWindow int64      //请求有效时间窗口(毫秒),目前只有binance支持,为0时使用交易所默认值
+
+	PositionMode model.PositionMode //账户当前的合约持仓模式,为空时下单前向交易所查询一次
+
+	WithdrawGuard *WithdrawGuard //提币安全检查,没有设置时不允许提币
+
+	CredentialProvider CredentialProvider //设置后签名时从provider获取凭证,忽略Key/Secret/Passphrase
 }
 
 type ApiOption func(options *ApiOptions)
@@ -32,3 +60,44 @@ func WithClientId(clientId string) ApiOption {
 		options.ClientId = clientId
 	}
 }
+
+// WithSignMethod 签名算法,使用RSA或Ed25519时通过WithApiSecretKey或CredentialProvider传入PEM格式的私钥
+func WithSignMethod(method SignMethod) ApiOption {
+	return func(options *ApiOptions) {
+		options.SignMethod = method
+	}
+}
+
+func WithClock(clock Clock) ApiOption {
+	return func(options *ApiOptions) {
+		options.Clock = clock
+	}
+}
+
+func WithRecvWindow(recvWindow time.Duration) ApiOption {
+	return func(options *ApiOptions) {
+		options.RecvWindow = recvWindow.Milliseconds()
+	}
+}
+
+// WithPositionMode 告知账户当前的持仓模式,合约下单时不再向交易所查询
+func WithPositionMode(mode model.PositionMode) ApiOption {
+	return func(options *ApiOptions) {
+		options.PositionMode = mode
+	}
+}
+
+// WithWithdrawGuard 设置提币的地址白名单、每日上限和确认回调,提币必须设置
+func WithWithdrawGuard(guard *WithdrawGuard) ApiOption {
+	return func(options *ApiOptions) {
+		options.WithdrawGuard = guard
+	}
+}
+
+// Now 签名时间,没有设置Clock时使用本地时间
+func (opts ApiOptions) Now() time.Time {
+	if opts.Clock != nil {
+		return opts.Clock.Now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,141 @@
+package options
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Credential API凭证,签名完成后调用Zero清空。
+// Secret只以[]byte参与签名,可以清零;Key和Passphrase需要放到请求头或者参数中,
+// 会被复制为string,Zero无法清除这些副本
+type Credential struct {
+	Key        []byte
+	Secret     []byte
+	Passphrase []byte
+}
+
+// Zero 将凭证的内容全部置0
+func (c *Credential) Zero() {
+	if c == nil {
+		return
+	}
+	for _, b := range [][]byte{c.Key, c.Secret, c.Passphrase} {
+		for i := range b {
+			b[i] = 0
+		}
+	}
+}
+
+// CredentialProvider 在每次签名时获取API凭证,调用方使用完后会调用Credential.Zero
+// 每次调用都需要返回新的Credential,不能返回内部缓存的切片
+type CredentialProvider interface {
+	Retrieve() (*Credential, error)
+}
+
+func WithCredentialProvider(provider CredentialProvider) ApiOption {
+	return func(options *ApiOptions) {
+		options.CredentialProvider = provider
+	}
+}
+
+// Credential 获取签名使用的凭证,设置了CredentialProvider时从provider获取,否则使用Key/Secret/Passphrase
+func (opts ApiOptions) Credential() (*Credential, error) {
+	if opts.CredentialProvider != nil {
+		cred, err := opts.CredentialProvider.Retrieve()
+		if err != nil {
+			return nil, fmt.Errorf("retrieve credential error: %w", err)
+		}
+		return cred, nil
+	}
+
+	return &Credential{
+		Key:        []byte(opts.Key),
+		Secret:     []byte(opts.Secret),
+		Passphrase: []byte(opts.Passphrase),
+	}, nil
+}
+
+// EnvCredentialProvider 从环境变量读取凭证: {Prefix}_API_KEY, {Prefix}_API_SECRET, {Prefix}_API_PASSPHRASE
+type EnvCredentialProvider struct {
+	Prefix string
+}
+
+func NewEnvCredentialProvider(prefix string) *EnvCredentialProvider {
+	return &EnvCredentialProvider{Prefix: strings.ToUpper(prefix)}
+}
+
+func (p *EnvCredentialProvider) Retrieve() (*Credential, error) {
+	key, secret := os.Getenv(p.Prefix+"_API_KEY"), os.Getenv(p.Prefix+"_API_SECRET")
+	if key == "" || secret == "" {
+		return nil, fmt.Errorf("env %s_API_KEY or %s_API_SECRET is empty", p.Prefix, p.Prefix)
+	}
+
+	return &Credential{
+		Key:        []byte(key),
+		Secret:     []byte(secret),
+		Passphrase: []byte(os.Getenv(p.Prefix + "_API_PASSPHRASE")),
+	}, nil
+}
+
+// FileCredentialProvider 从json或yaml配置文件读取凭证,按扩展名(.json/.yaml/.yml)解析,每次签名时重新读取文件
+//
+//	{"key": "...", "secret": "...", "passphrase": "..."}
+type FileCredentialProvider struct {
+	Path string
+}
+
+func NewFileCredentialProvider(path string) *FileCredentialProvider {
+	return &FileCredentialProvider{Path: path}
+}
+
+type fileCredential struct {
+	Key        string `json:"key" yaml:"key"`
+	Secret     string `json:"secret" yaml:"secret"`
+	Passphrase string `json:"passphrase" yaml:"passphrase"`
+}
+
+func (p *FileCredentialProvider) Retrieve() (*Credential, error) {
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(data)
+
+	var fc fileCredential
+	switch strings.ToLower(filepath.Ext(p.Path)) {
+	case ".json":
+		err = json.Unmarshal(data, &fc)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &fc)
+	default:
+		return nil, fmt.Errorf("unsupported credential file: %s", p.Path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if fc.Key == "" || fc.Secret == "" {
+		return nil, errors.New("credential file key or secret is empty")
+	}
+
+	return &Credential{
+		Key:        []byte(fc.Key),
+		Secret:     []byte(fc.Secret),
+		Passphrase: []byte(fc.Passphrase),
+	}, nil
+}
+
+func cloneBytes(b []byte) []byte {
+	return append([]byte(nil), b...)
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
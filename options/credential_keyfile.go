@@ -0,0 +1,172 @@
+package options
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+	"io"
+	"os"
+	"sync"
+)
+
+// keyfile格式: magic(6) | logN(1) | salt(16) | nonce(24) | secretbox(key\nsecret\npassphrase)
+const (
+	keyfileMagic    = "GOEXKF"
+	keyfileLogN     = 15
+	keyfileMinLogN  = 10 //读取时logN的取值范围,避免损坏或者伪造的文件导致scrypt占用过多内存和CPU
+	keyfileMaxLogN  = 20
+	keyfileSaltSize = 16
+)
+
+// PassphraseFunc 返回keyfile的解密口令,调用方用完后会清零
+type PassphraseFunc func() ([]byte, error)
+
+// KeyfileCredentialProvider 从NaCl secretbox加密的本地文件读取凭证,密钥由口令经scrypt派生
+// 第一次Retrieve时派生密钥(约几十毫秒、32MB内存)并解密,之后返回缓存凭证的副本,
+// 不再使用时调用Close清零缓存
+type KeyfileCredentialProvider struct {
+	Path       string
+	Passphrase PassphraseFunc
+
+	mu    sync.Mutex
+	plain []byte //解密后的key\nsecret\npassphrase
+}
+
+func NewKeyfileCredentialProvider(path string, passphrase PassphraseFunc) *KeyfileCredentialProvider {
+	return &KeyfileCredentialProvider{Path: path, Passphrase: passphrase}
+}
+
+// Retrieve 返回缓存凭证的副本,调用方可以放心调用Credential.Zero
+func (p *KeyfileCredentialProvider) Retrieve() (*Credential, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.plain == nil {
+		plain, err := p.decrypt()
+		if err != nil {
+			return nil, err
+		}
+		p.plain = plain
+	}
+
+	fields := bytes.SplitN(p.plain, []byte("\n"), 3)
+	cred := &Credential{Key: cloneBytes(fields[0]), Secret: cloneBytes(fields[1])}
+	if len(fields) == 3 {
+		cred.Passphrase = cloneBytes(fields[2])
+	}
+
+	return cred, nil
+}
+
+// Close 清零缓存的凭证,之后再调用Retrieve会重新读取文件并解密
+func (p *KeyfileCredentialProvider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	zeroBytes(p.plain)
+	p.plain = nil
+
+	return nil
+}
+
+func (p *KeyfileCredentialProvider) decrypt() ([]byte, error) {
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	headerLen := len(keyfileMagic) + 1 + keyfileSaltSize + 24
+	if len(data) < headerLen+secretbox.Overhead || string(data[:len(keyfileMagic)]) != keyfileMagic {
+		return nil, errors.New("invalid keyfile")
+	}
+
+	logN := data[len(keyfileMagic)]
+	if logN < keyfileMinLogN || logN > keyfileMaxLogN {
+		return nil, fmt.Errorf("invalid keyfile, logN %d out of range [%d, %d]", logN, keyfileMinLogN, keyfileMaxLogN)
+	}
+	salt := data[len(keyfileMagic)+1 : len(keyfileMagic)+1+keyfileSaltSize]
+	var nonce [24]byte
+	copy(nonce[:], data[len(keyfileMagic)+1+keyfileSaltSize:headerLen])
+
+	key, err := p.deriveKey(salt, logN)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(key[:])
+
+	plain, ok := secretbox.Open(nil, data[headerLen:], &nonce, key)
+	if !ok {
+		return nil, errors.New("decrypt keyfile error, wrong passphrase?")
+	}
+
+	if len(bytes.SplitN(plain, []byte("\n"), 3)) < 2 {
+		zeroBytes(plain)
+		return nil, errors.New("invalid keyfile content")
+	}
+
+	return plain, nil
+}
+
+func (p *KeyfileCredentialProvider) deriveKey(salt []byte, logN byte) (*[32]byte, error) {
+	if p.Passphrase == nil {
+		return nil, errors.New("keyfile passphrase func is nil")
+	}
+
+	passphrase, err := p.Passphrase()
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(passphrase)
+
+	dk, err := scrypt.Key(passphrase, salt, 1<<logN, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	defer zeroBytes(dk)
+
+	var key [32]byte
+	copy(key[:], dk)
+
+	return &key, nil
+}
+
+// WriteKeyfile 使用口令加密凭证并写入文件(权限0600),用于生成KeyfileCredentialProvider读取的文件
+func WriteKeyfile(path string, cred *Credential, passphrase []byte) error {
+	if bytes.ContainsRune(cred.Key, '\n') || bytes.ContainsRune(cred.Secret, '\n') {
+		return errors.New("credential can not contain newline")
+	}
+
+	salt := make([]byte, keyfileSaltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return err
+	}
+
+	var nonce [24]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		return err
+	}
+
+	dk, err := scrypt.Key(passphrase, salt, 1<<keyfileLogN, 8, 1, 32)
+	if err != nil {
+		return err
+	}
+	var key [32]byte
+	copy(key[:], dk)
+	zeroBytes(dk)
+	defer zeroBytes(key[:])
+
+	plain := bytes.Join([][]byte{cred.Key, cred.Secret, cred.Passphrase}, []byte("\n"))
+	defer zeroBytes(plain)
+
+	out := make([]byte, 0, len(keyfileMagic)+1+keyfileSaltSize+len(nonce)+len(plain)+secretbox.Overhead)
+	out = append(out, keyfileMagic...)
+	out = append(out, keyfileLogN)
+	out = append(out, salt...)
+	out = append(out, nonce[:]...)
+	out = secretbox.Seal(out, plain, &nonce, &key)
+
+	return os.WriteFile(path, out, 0600)
+}
@@ -0,0 +1,109 @@
+package options
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestKeyfile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "keyfile")
+	cred := &Credential{Key: []byte("key"), Secret: []byte("secret"), Passphrase: []byte("pass")}
+	if err := WriteKeyfile(path, cred, []byte("123456")); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func passphrase(p string) PassphraseFunc {
+	return func() ([]byte, error) { return []byte(p), nil }
+}
+
+func TestKeyfileCredentialProvider_Retrieve(t *testing.T) {
+	path := writeTestKeyfile(t)
+
+	var calls int
+	p := NewKeyfileCredentialProvider(path, func() ([]byte, error) {
+		calls++
+		return []byte("123456"), nil
+	})
+	defer p.Close()
+
+	for i := 0; i < 3; i++ {
+		cred, err := p.Retrieve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(cred.Key) != "key" || string(cred.Secret) != "secret" || string(cred.Passphrase) != "pass" {
+			t.Fatalf("unexpected credential: %q %q %q", cred.Key, cred.Secret, cred.Passphrase)
+		}
+		//清零返回的副本不影响缓存
+		cred.Zero()
+	}
+
+	if calls != 1 {
+		t.Fatalf("passphrase func called %d times, want 1", calls)
+	}
+
+	cached := p.plain
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(cached, make([]byte, len(cached))) {
+		t.Fatal("cached credential is not zeroed after Close")
+	}
+
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Fatalf("passphrase func called %d times after Close, want 2", calls)
+	}
+}
+
+func TestKeyfileCredentialProvider_RetrieveError(t *testing.T) {
+	path := writeTestKeyfile(t)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patch := func(i int, b byte) []byte {
+		out := append([]byte(nil), data...)
+		out[i] = b
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		data       []byte
+		passphrase string
+	}{
+		{name: "wrong passphrase", data: data, passphrase: "654321"},
+		{name: "truncated", data: data[:len(data)-20], passphrase: "123456"},
+		{name: "header only", data: data[:10], passphrase: "123456"},
+		{name: "bad magic", data: patch(0, 'X'), passphrase: "123456"},
+		{name: "logN too small", data: patch(len(keyfileMagic), keyfileMinLogN-1), passphrase: "123456"},
+		{name: "logN too large", data: patch(len(keyfileMagic), keyfileMaxLogN+5), passphrase: "123456"},
+		{name: "tampered ciphertext", data: patch(len(data)-1, data[len(data)-1]^0xff), passphrase: "123456"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "keyfile")
+			if err := os.WriteFile(file, tt.data, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			p := NewKeyfileCredentialProvider(file, passphrase(tt.passphrase))
+			if cred, err := p.Retrieve(); err == nil {
+				t.Fatalf("expected error, got credential %q", cred.Key)
+			}
+			if p.plain != nil {
+				t.Fatal("failed Retrieve should not cache anything")
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package options
+
+import (
+	"context"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"time"
+)
+
+// Environment 交易所环境,交易所只有一种沙盒环境时Demo和Testnet等价
+type Environment string
+
+const (
+	Env_Production Environment = "production"
+	Env_Demo       Environment = "demo"    //模拟盘
+	Env_Testnet    Environment = "testnet" //测试网
+)
+
+// ExchangeOptions 创建交易所实例时的选项
+type ExchangeOptions struct {
+	Env                   Environment
+	Logger                logger.ILogger  //输出前会自动隐藏api key、签名和passphrase
+	EndpointProbeInterval time.Duration   //大于0时构造交易所实例后启动备用域名池的延迟探测
+	Context               context.Context //所有请求的父context,请求的trace span是它的子span
+}
+
+type ExchangeOption func(options *ExchangeOptions)
+
+func WithEnvironment(env Environment) ExchangeOption {
+	return func(options *ExchangeOptions) {
+		options.Env = env
+	}
+}
+
+// WithLogger 设置交易所实例的日志,可以传入*slog.Logger
+func WithLogger(l logger.ILogger) ExchangeOption {
+	return func(options *ExchangeOptions) {
+		if l == nil {
+			l = logger.Default()
+		}
+		options.Logger = logger.Redact(l)
+	}
+}
+
+// WithContext 设置交易所实例所有请求的父context,例如:
+//
+//	ctx, span := tracer.Start(ctx, "strategy")
+//	defer span.End()
+//	spot := okx.New(options.WithContext(ctx))
+//
+// 实例的请求span都会成为span的子span;ctx取消后实现了httpcli.IContextHttpClient的http客户端会中断请求
+func WithContext(ctx context.Context) ExchangeOption {
+	return func(options *ExchangeOptions) {
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		options.Context = ctx
+	}
+}
+
+// WithEndpointProbe 构造交易所实例后启动UriOptions.EndpointPool的后台延迟探测,
+// 不设置时按默认顺序使用域名,只在失败时切换
+func WithEndpointProbe(interval time.Duration) ExchangeOption {
+	return func(options *ExchangeOptions) {
+		options.EndpointProbeInterval = interval
+	}
+}
+
+// NewExchangeOptions 默认为生产环境,日志输出到全局的logger
+func NewExchangeOptions(opts ...ExchangeOption) ExchangeOptions {
+	exOpts := ExchangeOptions{Env: Env_Production, Logger: logger.Default(), Context: context.Background()}
+	for _, opt := range opts {
+		opt(&exOpts)
+	}
+	return exOpts
+}
+
+// IsSandbox 是否为模拟盘或测试网
+func (opts ExchangeOptions) IsSandbox() bool {
+	return opts.Env == Env_Demo || opts.Env == Env_Testnet
+}
@@ -15,21 +15,84 @@ type GetAccountResponseUnmarshaler func([]byte) (map[string]model.Account, error
 type GetPositionsResponseUnmarshaler func([]byte) ([]model.FuturesPosition, error)
 type GetFuturesAccountResponseUnmarshaler func([]byte) (map[string]model.FuturesAccount, error)
 type GetExchangeInfoResponseUnmarshaler func([]byte) (map[string]model.CurrencyPair, error)
+type GetOptionSummaryResponseUnmarshaler func([]byte) ([]model.OptionSummary, error)
+type CreateAlgoOrderResponseUnmarshaler func([]byte) (*model.AlgoOrder, error)
+type GetPendingAlgoOrdersResponseUnmarshaler func([]byte) ([]model.AlgoOrder, error)
+type GetHistoryAlgoOrdersResponseUnmarshaler func([]byte) ([]model.AlgoOrder, error)
+type CancelAlgoOrdersResponseUnmarshaler func([]byte) error
+type CreateOrdersResponseUnmarshaler func([]byte) ([]model.BatchOrderResult, error)
+type CancelOrdersResponseUnmarshaler func([]byte) ([]model.BatchOrderResult, error)
+type AmendOrderResponseUnmarshaler func([]byte) (*model.Order, error)
+type GetFillsResponseUnmarshaler func([]byte) ([]model.Trade, error)
+type GetTradesResponseUnmarshaler func([]byte) ([]model.Trade, error)
+type GetFundingRateResponseUnmarshaler func([]byte) (*model.FundingRate, error)
+type GetFundingRateHistoryResponseUnmarshaler func([]byte) ([]model.FundingRate, error)
+type GetMarkPriceResponseUnmarshaler func([]byte) (*model.MarkPrice, error)
+type GetIndexPriceResponseUnmarshaler func([]byte) (*model.IndexPrice, error)
+type GetOpenInterestHistoryResponseUnmarshaler func([]byte) ([]model.OpenInterest, error)
+type GetLongShortRatioResponseUnmarshaler func([]byte) ([]model.LongShortRatio, error)
+type GetTakerVolumeResponseUnmarshaler func([]byte) ([]model.TakerVolume, error)
+type GetLeverageResponseUnmarshaler func([]byte) (float64, error)
+type TransferResponseUnmarshaler func([]byte) (string, error)
+type GetTransferHistoryResponseUnmarshaler func([]byte) ([]model.TransferRecord, error)
+type GetCoinNetworksResponseUnmarshaler func([]byte) ([]model.CoinNetwork, error)
+type GetDepositAddressResponseUnmarshaler func([]byte) ([]model.DepositAddress, error)
+type GetDepositHistoryResponseUnmarshaler func([]byte) ([]model.WalletRecord, error)
+type GetWithdrawHistoryResponseUnmarshaler func([]byte) ([]model.WalletRecord, error)
+type WithdrawResponseUnmarshaler func([]byte) (string, error)
+type GetSubAccountsResponseUnmarshaler func([]byte) ([]model.SubAccount, error)
+type CreateSubAccountApiKeyResponseUnmarshaler func([]byte) (*model.SubAccountApiKey, error)
+type GetBillsResponseUnmarshaler func([]byte) ([]model.Bill, error)
+type GetTradeFeeResponseUnmarshaler func([]byte) (*model.TradeFee, error)
+type GetServerTimeResponseUnmarshaler func([]byte) (int64, error)
+type GetPositionModeResponseUnmarshaler func([]byte) (model.PositionMode, error)
 
 type UnmarshalerOptions struct {
-	ResponseUnmarshaler                  ResponseUnmarshaler
-	TickerUnmarshaler                    GetTickerResponseUnmarshaler
-	DepthUnmarshaler                     GetDepthResponseUnmarshaler
-	KlineUnmarshaler                     GetKlineResponseUnmarshaler
-	CreateOrderResponseUnmarshaler       CreateOrderResponseUnmarshaler
-	GetOrderInfoResponseUnmarshaler      GetOrderInfoResponseUnmarshaler
-	GetPendingOrdersResponseUnmarshaler  GetPendingOrdersResponseUnmarshaler
-	GetHistoryOrdersResponseUnmarshaler  GetHistoryOrdersResponseUnmarshaler
-	CancelOrderResponseUnmarshaler       CancelOrderResponseUnmarshaler
-	GetAccountResponseUnmarshaler        GetAccountResponseUnmarshaler
-	GetPositionsResponseUnmarshaler      GetPositionsResponseUnmarshaler
-	GetFuturesAccountResponseUnmarshaler GetFuturesAccountResponseUnmarshaler
-	GetExchangeInfoResponseUnmarshaler   GetExchangeInfoResponseUnmarshaler
+	ResponseUnmarshaler                       ResponseUnmarshaler
+	TickerUnmarshaler                         GetTickerResponseUnmarshaler
+	DepthUnmarshaler                          GetDepthResponseUnmarshaler
+	KlineUnmarshaler                          GetKlineResponseUnmarshaler
+	CreateOrderResponseUnmarshaler            CreateOrderResponseUnmarshaler
+	GetOrderInfoResponseUnmarshaler           GetOrderInfoResponseUnmarshaler
+	GetPendingOrdersResponseUnmarshaler       GetPendingOrdersResponseUnmarshaler
+	GetHistoryOrdersResponseUnmarshaler       GetHistoryOrdersResponseUnmarshaler
+	CancelOrderResponseUnmarshaler            CancelOrderResponseUnmarshaler
+	GetAccountResponseUnmarshaler             GetAccountResponseUnmarshaler
+	GetPositionsResponseUnmarshaler           GetPositionsResponseUnmarshaler
+	GetFuturesAccountResponseUnmarshaler      GetFuturesAccountResponseUnmarshaler
+	GetExchangeInfoResponseUnmarshaler        GetExchangeInfoResponseUnmarshaler
+	GetOptionSummaryResponseUnmarshaler       GetOptionSummaryResponseUnmarshaler
+	CreateAlgoOrderResponseUnmarshaler        CreateAlgoOrderResponseUnmarshaler
+	GetPendingAlgoOrdersResponseUnmarshaler   GetPendingAlgoOrdersResponseUnmarshaler
+	GetHistoryAlgoOrdersResponseUnmarshaler   GetHistoryAlgoOrdersResponseUnmarshaler
+	CancelAlgoOrdersResponseUnmarshaler       CancelAlgoOrdersResponseUnmarshaler
+	CreateOrdersResponseUnmarshaler           CreateOrdersResponseUnmarshaler
+	CancelOrdersResponseUnmarshaler           CancelOrdersResponseUnmarshaler
+	AmendOrderResponseUnmarshaler             AmendOrderResponseUnmarshaler
+	GetFillsResponseUnmarshaler               GetFillsResponseUnmarshaler
+	GetTradesResponseUnmarshaler              GetTradesResponseUnmarshaler
+	GetAggTradesResponseUnmarshaler           GetTradesResponseUnmarshaler
+	GetFundingRateResponseUnmarshaler         GetFundingRateResponseUnmarshaler
+	GetFundingRateHistoryResponseUnmarshaler  GetFundingRateHistoryResponseUnmarshaler
+	GetMarkPriceResponseUnmarshaler           GetMarkPriceResponseUnmarshaler
+	GetIndexPriceResponseUnmarshaler          GetIndexPriceResponseUnmarshaler
+	GetOpenInterestHistoryResponseUnmarshaler GetOpenInterestHistoryResponseUnmarshaler
+	GetLongShortRatioResponseUnmarshaler      GetLongShortRatioResponseUnmarshaler
+	GetTakerVolumeResponseUnmarshaler         GetTakerVolumeResponseUnmarshaler
+	GetLeverageResponseUnmarshaler            GetLeverageResponseUnmarshaler
+	TransferResponseUnmarshaler               TransferResponseUnmarshaler
+	GetTransferHistoryResponseUnmarshaler     GetTransferHistoryResponseUnmarshaler
+	GetCoinNetworksResponseUnmarshaler        GetCoinNetworksResponseUnmarshaler
+	GetDepositAddressResponseUnmarshaler      GetDepositAddressResponseUnmarshaler
+	GetDepositHistoryResponseUnmarshaler      GetDepositHistoryResponseUnmarshaler
+	GetWithdrawHistoryResponseUnmarshaler     GetWithdrawHistoryResponseUnmarshaler
+	WithdrawResponseUnmarshaler               WithdrawResponseUnmarshaler
+	GetSubAccountsResponseUnmarshaler         GetSubAccountsResponseUnmarshaler
+	CreateSubAccountApiKeyResponseUnmarshaler CreateSubAccountApiKeyResponseUnmarshaler
+	GetBillsResponseUnmarshaler               GetBillsResponseUnmarshaler
+	GetTradeFeeResponseUnmarshaler            GetTradeFeeResponseUnmarshaler
+	GetServerTimeResponseUnmarshaler          GetServerTimeResponseUnmarshaler
+	GetPositionModeResponseUnmarshaler        GetPositionModeResponseUnmarshaler
 }
 
 type UnmarshalerOption func(options *UnmarshalerOptions)
@@ -110,3 +173,195 @@ func WithGetExchangeInfoResponseUnmarshaler(unmarshaler GetExchangeInfoResponseU
 		options.GetExchangeInfoResponseUnmarshaler = unmarshaler
 	}
 }
+
+func WithGetOptionSummaryResponseUnmarshaler(unmarshaler GetOptionSummaryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetOptionSummaryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithCreateAlgoOrderResponseUnmarshaler(unmarshaler CreateAlgoOrderResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.CreateAlgoOrderResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetPendingAlgoOrdersResponseUnmarshaler(unmarshaler GetPendingAlgoOrdersResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetPendingAlgoOrdersResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetHistoryAlgoOrdersResponseUnmarshaler(unmarshaler GetHistoryAlgoOrdersResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetHistoryAlgoOrdersResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithCancelAlgoOrdersResponseUnmarshaler(unmarshaler CancelAlgoOrdersResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.CancelAlgoOrdersResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithCreateOrdersResponseUnmarshaler(unmarshaler CreateOrdersResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.CreateOrdersResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithCancelOrdersResponseUnmarshaler(unmarshaler CancelOrdersResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.CancelOrdersResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithAmendOrderResponseUnmarshaler(unmarshaler AmendOrderResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.AmendOrderResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetFillsResponseUnmarshaler(unmarshaler GetFillsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetFillsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetTradesResponseUnmarshaler(unmarshaler GetTradesResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetTradesResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetAggTradesResponseUnmarshaler(unmarshaler GetTradesResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetAggTradesResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetFundingRateResponseUnmarshaler(unmarshaler GetFundingRateResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetFundingRateResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetFundingRateHistoryResponseUnmarshaler(unmarshaler GetFundingRateHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetFundingRateHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetMarkPriceResponseUnmarshaler(unmarshaler GetMarkPriceResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetMarkPriceResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetIndexPriceResponseUnmarshaler(unmarshaler GetIndexPriceResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetIndexPriceResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetOpenInterestHistoryResponseUnmarshaler(unmarshaler GetOpenInterestHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetOpenInterestHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetLongShortRatioResponseUnmarshaler(unmarshaler GetLongShortRatioResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetLongShortRatioResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetTakerVolumeResponseUnmarshaler(unmarshaler GetTakerVolumeResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetTakerVolumeResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetLeverageResponseUnmarshaler(unmarshaler GetLeverageResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetLeverageResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithTransferResponseUnmarshaler(unmarshaler TransferResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.TransferResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetTransferHistoryResponseUnmarshaler(unmarshaler GetTransferHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetTransferHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetCoinNetworksResponseUnmarshaler(unmarshaler GetCoinNetworksResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetCoinNetworksResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetDepositAddressResponseUnmarshaler(unmarshaler GetDepositAddressResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetDepositAddressResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetDepositHistoryResponseUnmarshaler(unmarshaler GetDepositHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetDepositHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetWithdrawHistoryResponseUnmarshaler(unmarshaler GetWithdrawHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetWithdrawHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithWithdrawResponseUnmarshaler(unmarshaler WithdrawResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.WithdrawResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetSubAccountsResponseUnmarshaler(unmarshaler GetSubAccountsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetSubAccountsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithCreateSubAccountApiKeyResponseUnmarshaler(unmarshaler CreateSubAccountApiKeyResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.CreateSubAccountApiKeyResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetBillsResponseUnmarshaler(unmarshaler GetBillsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetBillsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetTradeFeeResponseUnmarshaler(unmarshaler GetTradeFeeResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetTradeFeeResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetServerTimeResponseUnmarshaler(unmarshaler GetServerTimeResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetServerTimeResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetPositionModeResponseUnmarshaler(unmarshaler GetPositionModeResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetPositionModeResponseUnmarshaler = unmarshaler
+	}
+}
@@ -1,18 +1,64 @@
 package options
 
+import "github.com/nntaoli-project/goex/v2/httpcli"
+
 type UriOptions struct {
-	Endpoint            string
-	TickerUri           string
-	DepthUri            string
-	KlineUri            string
-	GetOrderUri         string
-	GetPendingOrdersUri string
-	GetHistoryOrdersUri string
-	CancelOrderUri      string
-	NewOrderUri         string
-	GetAccountUri       string
-	GetPositionsUri     string
-	GetExchangeInfoUri  string
+	Endpoint                  string
+	EndpointPool              *httpcli.EndpointPool //Endpoint的备用域名池,为nil时只使用Endpoint
+	TickerUri                 string
+	DepthUri                  string
+	KlineUri                  string
+	GetOrderUri               string
+	GetPendingOrdersUri       string
+	GetHistoryOrdersUri       string
+	CancelOrderUri            string
+	NewOrderUri               string
+	GetAccountUri             string
+	GetPositionsUri           string
+	GetExchangeInfoUri        string
+	GetOptionSummaryUri       string
+	NewAlgoOrderUri           string
+	CancelAlgoOrdersUri       string
+	GetPendingAlgoOrdersUri   string
+	GetHistoryAlgoOrdersUri   string
+	NewBatchOrdersUri         string
+	CancelBatchOrdersUri      string
+	AmendOrderUri             string
+	CancelAllOrdersUri        string
+	CancelAllAfterUri         string
+	GetFillsUri               string
+	GetFillsHistoryUri        string
+	GetTradesUri              string
+	GetAggTradesUri           string
+	GetFundingRateUri         string
+	GetFundingRateHistoryUri  string
+	GetMarkPriceUri           string
+	GetIndexPriceUri          string
+	GetOpenInterestHistoryUri string
+	GetLongShortRatioUri      string
+	GetTakerVolumeUri         string
+	SetLeverageUri            string
+	GetLeverageUri            string
+	SetMarginModeUri          string
+	SetPositionModeUri        string
+	TransferUri               string
+	GetTransferHistoryUri     string
+	GetCoinNetworksUri        string
+	GetDepositAddressUri      string
+	GetDepositHistoryUri      string
+	GetWithdrawHistoryUri     string
+	WithdrawUri               string
+	CancelWithdrawUri         string
+	GetSubAccountsUri         string
+	GetSubAccountBalanceUri   string
+	SubAccountTransferUri     string
+	CreateSubAccountApiKeyUri string
+	GetBillsUri               string
+	GetBillsArchiveUri        string
+	GetTradeFeeUri            string
+	GetServerTimeUri          string
+	GetPositionModeUri        string
+	GetUnderlyingUri          string
 }
 
 type UriOption func(*UriOptions)
@@ -23,6 +69,12 @@ func WithEndpoint(endpoint string) UriOption {
 	}
 }
 
+func WithEndpointPool(pool *httpcli.EndpointPool) UriOption {
+	return func(c *UriOptions) {
+		c.EndpointPool = pool
+	}
+}
+
 func WithTickerUri(uri string) UriOption {
 	return func(c *UriOptions) {
 		c.TickerUri = uri
@@ -88,3 +140,261 @@ func WithGetExchangeUri(uri string) UriOption {
 		c.GetExchangeInfoUri = uri
 	}
 }
+
+func WithGetOptionSummaryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetOptionSummaryUri = uri
+	}
+}
+
+func WithNewAlgoOrderUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.NewAlgoOrderUri = uri
+	}
+}
+
+func WithCancelAlgoOrdersUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.CancelAlgoOrdersUri = uri
+	}
+}
+
+func WithGetPendingAlgoOrdersUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetPendingAlgoOrdersUri = uri
+	}
+}
+
+func WithGetHistoryAlgoOrdersUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetHistoryAlgoOrdersUri = uri
+	}
+}
+
+func WithNewBatchOrdersUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.NewBatchOrdersUri = uri
+	}
+}
+
+func WithCancelBatchOrdersUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.CancelBatchOrdersUri = uri
+	}
+}
+
+func WithAmendOrderUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.AmendOrderUri = uri
+	}
+}
+
+func WithCancelAllOrdersUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.CancelAllOrdersUri = uri
+	}
+}
+
+func WithCancelAllAfterUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.CancelAllAfterUri = uri
+	}
+}
+
+func WithGetFillsUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetFillsUri = uri
+	}
+}
+
+func WithGetFillsHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetFillsHistoryUri = uri
+	}
+}
+
+func WithGetTradesUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetTradesUri = uri
+	}
+}
+
+func WithGetAggTradesUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetAggTradesUri = uri
+	}
+}
+
+func WithGetFundingRateUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetFundingRateUri = uri
+	}
+}
+
+func WithGetFundingRateHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetFundingRateHistoryUri = uri
+	}
+}
+
+func WithGetMarkPriceUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetMarkPriceUri = uri
+	}
+}
+
+func WithGetIndexPriceUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetIndexPriceUri = uri
+	}
+}
+
+func WithGetOpenInterestHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetOpenInterestHistoryUri = uri
+	}
+}
+
+func WithGetLongShortRatioUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetLongShortRatioUri = uri
+	}
+}
+
+func WithGetTakerVolumeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetTakerVolumeUri = uri
+	}
+}
+
+func WithSetLeverageUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SetLeverageUri = uri
+	}
+}
+
+func WithGetLeverageUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetLeverageUri = uri
+	}
+}
+
+func WithSetMarginModeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SetMarginModeUri = uri
+	}
+}
+
+func WithSetPositionModeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SetPositionModeUri = uri
+	}
+}
+
+func WithTransferUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.TransferUri = uri
+	}
+}
+
+func WithGetTransferHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetTransferHistoryUri = uri
+	}
+}
+
+func WithGetCoinNetworksUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetCoinNetworksUri = uri
+	}
+}
+
+func WithGetDepositAddressUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetDepositAddressUri = uri
+	}
+}
+
+func WithGetDepositHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetDepositHistoryUri = uri
+	}
+}
+
+func WithGetWithdrawHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetWithdrawHistoryUri = uri
+	}
+}
+
+func WithWithdrawUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.WithdrawUri = uri
+	}
+}
+
+func WithCancelWithdrawUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.CancelWithdrawUri = uri
+	}
+}
+
+func WithGetSubAccountsUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetSubAccountsUri = uri
+	}
+}
+
+func WithGetSubAccountBalanceUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetSubAccountBalanceUri = uri
+	}
+}
+
+func WithSubAccountTransferUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SubAccountTransferUri = uri
+	}
+}
+
+func WithCreateSubAccountApiKeyUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.CreateSubAccountApiKeyUri = uri
+	}
+}
+
+func WithGetBillsUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetBillsUri = uri
+	}
+}
+
+func WithGetBillsArchiveUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetBillsArchiveUri = uri
+	}
+}
+
+func WithGetTradeFeeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetTradeFeeUri = uri
+	}
+}
+
+func WithGetServerTimeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetServerTimeUri = uri
+	}
+}
+
+func WithGetPositionModeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetPositionModeUri = uri
+	}
+}
+
+func WithGetUnderlyingUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetUnderlyingUri = uri
+	}
+}
@@ -0,0 +1,248 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"sync"
+	"time"
+)
+
+// WithdrawConfirmFunc 提币前的确认回调(dry-run),返回nil才会真正发送提币请求
+type WithdrawConfirmFunc func(req model.WithdrawRequest) error
+
+// ErrWithdrawRejected 交易所明确拒绝了提币请求,可以通过errors.Is判断
+var ErrWithdrawRejected = errors.New("withdraw rejected by exchange")
+
+type withdrawRejectedError struct {
+	err error
+}
+
+func (e withdrawRejectedError) Error() string {
+	return e.err.Error()
+}
+
+func (e withdrawRejectedError) Unwrap() error {
+	return e.err
+}
+
+func (e withdrawRejectedError) Is(target error) bool {
+	return target == ErrWithdrawRejected
+}
+
+// WithdrawRejected 交易所的Withdraw实现在收到明确的拒绝(业务错误码、4xx)时用它包装err,
+// WithdrawGuard只在这种错误时释放预占的额度
+func WithdrawRejected(err error) error {
+	if err == nil {
+		return nil
+	}
+	return withdrawRejectedError{err: err}
+}
+
+// WithdrawGuard 提币安全检查,通过WithWithdrawGuard设置,没有设置时交易所的Withdraw直接返回错误:
+//   - 地址白名单,只允许提币到AllowAddress添加的地址
+//   - 每个币种的每日(UTC)提币上限,没有设置上限的币种不允许提币
+//   - 必须设置的确认回调,所有检查通过后调用,确认后才发送请求
+//
+// 多个ApiOptions可以共用同一个WithdrawGuard,额度按所有账户累计
+type WithdrawGuard struct {
+	confirm   WithdrawConfirmFunc
+	allowlist map[string]map[string]struct{} //coin -> address(含tag)
+	dailyCaps map[string]float64
+	logger    logger.ILogger
+	now       func() time.Time
+
+	mu      sync.Mutex
+	day     string
+	spent   map[string]float64        //当日已提币和正在提币(预占)的数量
+	records map[string]withdrawRecord //提币ID -> 当日计入的额度,CancelWithdraw成功后返还
+}
+
+type withdrawRecord struct {
+	day    string
+	coin   string
+	amount float64
+}
+
+func NewWithdrawGuard(confirm WithdrawConfirmFunc) *WithdrawGuard {
+	return &WithdrawGuard{
+		confirm:   confirm,
+		allowlist: make(map[string]map[string]struct{}, 4),
+		dailyCaps: make(map[string]float64, 4),
+		logger:    logger.Default(),
+		now:       time.Now,
+		spent:     make(map[string]float64, 4),
+		records:   make(map[string]withdrawRecord, 4),
+	}
+}
+
+// SetLogger 提币和撤销提币的日志,l为nil时使用logger.Default()
+func (g *WithdrawGuard) SetLogger(l logger.ILogger) *WithdrawGuard {
+	if l == nil {
+		l = logger.Default()
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.logger = logger.Redact(l)
+	return g
+}
+
+// AllowAddress 添加提币地址白名单,tag不需要时传空
+func (g *WithdrawGuard) AllowAddress(coin, address, tag string) *WithdrawGuard {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.allowlist[coin] == nil {
+		g.allowlist[coin] = make(map[string]struct{}, 2)
+	}
+	g.allowlist[coin][addressKey(address, tag)] = struct{}{}
+
+	return g
+}
+
+// SetDailyCap 设置币种每日(UTC)累计提币数量上限
+func (g *WithdrawGuard) SetDailyCap(coin string, amount float64) *WithdrawGuard {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.dailyCaps[coin] = amount
+	return g
+}
+
+// Withdraw 检查地址白名单和每日上限后先预占额度,确认回调通过后调用do发送提币请求。
+// 只有确认回调失败或者交易所明确拒绝(ErrWithdrawRejected)时释放额度,
+// 超时、网络错误等无法确定交易所是否已受理的情况仍然计入当日额度,避免重试超过上限
+func (g *WithdrawGuard) Withdraw(req model.WithdrawRequest, do func() (string, []byte, error)) (string, []byte, error) {
+	if g == nil {
+		return "", nil, errors.New("withdraw guard is required, please set options.WithWithdrawGuard")
+	}
+
+	if g.confirm == nil {
+		return "", nil, errors.New("withdraw confirm hook is required")
+	}
+
+	if req.Amount <= 0 {
+		return "", nil, fmt.Errorf("withdraw amount %v error", req.Amount)
+	}
+
+	day, err := g.reserve(req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err = g.confirm(req); err != nil {
+		g.release(day, req.Coin, req.Amount)
+		return "", nil, fmt.Errorf("withdraw not confirmed: %w", err)
+	}
+
+	id, responseBody, err := do()
+	if err != nil {
+		if errors.Is(err, ErrWithdrawRejected) {
+			g.release(day, req.Coin, req.Amount)
+		} else {
+			g.log().Warn("withdraw result unknown, amount stays counted in the daily cap",
+				"coin", req.Coin, "amount", req.Amount, "address", req.Address, "error", err)
+		}
+		return id, responseBody, err
+	}
+
+	g.mu.Lock()
+	if id != "" {
+		g.records[id] = withdrawRecord{day: day, coin: req.Coin, amount: req.Amount}
+	}
+	g.mu.Unlock()
+
+	g.log().Info("withdraw", "coin", req.Coin, "amount", req.Amount, "address", req.Address, "id", id)
+
+	return id, responseBody, nil
+}
+
+// Refund 撤销提币成功后返还当日额度,非当日的提币只删除记录
+func (g *WithdrawGuard) Refund(id string) {
+	if g == nil {
+		return
+	}
+
+	g.mu.Lock()
+	rec, ok := g.records[id]
+	if ok {
+		delete(g.records, id)
+	}
+	g.mu.Unlock()
+
+	if ok && g.release(rec.day, rec.coin, rec.amount) {
+		g.log().Info("cancel withdraw", "coin", rec.coin, "amount", rec.amount, "id", id)
+	}
+}
+
+// DailySpent 当日(UTC)已提币数量,包含结果未知的提币
+func (g *WithdrawGuard) DailySpent(coin string) float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.resetIfNewDay()
+	return g.spent[coin]
+}
+
+// reserve 检查通过后把req.Amount计入当日额度,返回计入的日期
+func (g *WithdrawGuard) reserve(req model.WithdrawRequest) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.allowlist[req.Coin][addressKey(req.Address, req.Tag)]; !ok {
+		return "", fmt.Errorf("withdraw address %s is not in the %s allowlist", req.Address, req.Coin)
+	}
+
+	capAmount, ok := g.dailyCaps[req.Coin]
+	if !ok {
+		return "", fmt.Errorf("%s has no daily withdraw cap", req.Coin)
+	}
+
+	g.resetIfNewDay()
+	if g.spent[req.Coin]+req.Amount > capAmount {
+		return "", fmt.Errorf("%s daily withdraw cap %v exceeded, spent %v, request %v",
+			req.Coin, capAmount, g.spent[req.Coin], req.Amount)
+	}
+
+	g.spent[req.Coin] += req.Amount
+
+	return g.day, nil
+}
+
+// release 返还day计入的额度,已经跨天时不需要返还
+func (g *WithdrawGuard) release(day, coin string, amount float64) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.resetIfNewDay()
+	if day != g.day {
+		return false
+	}
+	g.spent[coin] -= amount
+	return true
+}
+
+func (g *WithdrawGuard) log() logger.ILogger {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.logger
+}
+
+func (g *WithdrawGuard) resetIfNewDay() {
+	today := g.now().UTC().Format("2006-01-02")
+	if g.day != today {
+		g.day = today
+		g.spent = make(map[string]float64, len(g.dailyCaps))
+		for id, rec := range g.records {
+			if rec.day != today {
+				delete(g.records, id)
+			}
+		}
+	}
+}
+
+func addressKey(address, tag string) string {
+	if tag == "" {
+		return address
+	}
+	return address + ":" + tag
+}
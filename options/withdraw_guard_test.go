@@ -0,0 +1,220 @@
+package options
+
+import (
+	"errors"
+	"github.com/nntaoli-project/goex/v2/model"
+	"net"
+	"testing"
+	"time"
+)
+
+var errTimeout = &net.OpError{Op: "read", Err: errors.New("i/o timeout")}
+
+func newTestGuard(confirm WithdrawConfirmFunc) *WithdrawGuard {
+	return NewWithdrawGuard(confirm).
+		AllowAddress("USDT", "addr1", "").
+		AllowAddress("XRP", "addr2", "memo").
+		SetDailyCap("USDT", 100).
+		SetDailyCap("XRP", 100)
+}
+
+func TestWithdrawGuard_Withdraw(t *testing.T) {
+	accept := func(model.WithdrawRequest) error { return nil }
+
+	tests := []struct {
+		name      string
+		confirm   WithdrawConfirmFunc
+		req       model.WithdrawRequest
+		doErr     error
+		wantErr   bool
+		wantCall  bool
+		wantSpent float64
+	}{
+		{
+			name:      "ok",
+			confirm:   accept,
+			req:       model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 60},
+			wantCall:  true,
+			wantSpent: 60,
+		},
+		{
+			name:      "ok with tag",
+			confirm:   accept,
+			req:       model.WithdrawRequest{Coin: "XRP", Address: "addr2", Tag: "memo", Amount: 10},
+			wantCall:  true,
+			wantSpent: 10,
+		},
+		{
+			name:    "tag not in allowlist",
+			confirm: accept,
+			req:     model.WithdrawRequest{Coin: "XRP", Address: "addr2", Amount: 10},
+			wantErr: true,
+		},
+		{
+			name:    "address not in allowlist",
+			confirm: accept,
+			req:     model.WithdrawRequest{Coin: "USDT", Address: "evil", Amount: 10},
+			wantErr: true,
+		},
+		{
+			name:    "coin without cap",
+			confirm: accept,
+			req:     model.WithdrawRequest{Coin: "BTC", Address: "addr1", Amount: 1},
+			wantErr: true,
+		},
+		{
+			name:    "cap exceeded",
+			confirm: accept,
+			req:     model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 101},
+			wantErr: true,
+		},
+		{
+			name:    "zero amount",
+			confirm: accept,
+			req:     model.WithdrawRequest{Coin: "USDT", Address: "addr1"},
+			wantErr: true,
+		},
+		{
+			name:    "no confirm hook",
+			req:     model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 10},
+			wantErr: true,
+		},
+		{
+			name:    "not confirmed",
+			confirm: func(model.WithdrawRequest) error { return errors.New("dry run") },
+			req:     model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 10},
+			wantErr: true,
+		},
+		{
+			name:     "rejected by exchange releases the amount",
+			confirm:  accept,
+			req:      model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 10},
+			doErr:    WithdrawRejected(errors.New("insufficient balance")),
+			wantErr:  true,
+			wantCall: true,
+		},
+		{
+			name:      "unknown result keeps the amount",
+			confirm:   accept,
+			req:       model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 10},
+			doErr:     errTimeout,
+			wantErr:   true,
+			wantCall:  true,
+			wantSpent: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGuard(tt.confirm)
+
+			var called bool
+			_, _, err := g.Withdraw(tt.req, func() (string, []byte, error) {
+				called = true
+				if tt.doErr != nil {
+					return "", nil, tt.doErr
+				}
+				return "wd-1", nil, nil
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if called != tt.wantCall {
+				t.Fatalf("do called = %v, want %v", called, tt.wantCall)
+			}
+			if spent := g.DailySpent(tt.req.Coin); spent != tt.wantSpent {
+				t.Fatalf("spent = %v, want %v", spent, tt.wantSpent)
+			}
+		})
+	}
+}
+
+func TestWithdrawGuard_NilGuard(t *testing.T) {
+	var g *WithdrawGuard
+	_, _, err := g.Withdraw(model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 1}, func() (string, []byte, error) {
+		t.Fatal("do should not be called")
+		return "", nil, nil
+	})
+	if err == nil {
+		t.Fatal("expected error for nil guard")
+	}
+	g.Refund("wd-1")
+}
+
+func TestWithdrawGuard_RetryAfterUnknownResult(t *testing.T) {
+	g := newTestGuard(func(model.WithdrawRequest) error { return nil })
+	req := model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 60}
+
+	_, _, err := g.Withdraw(req, func() (string, []byte, error) {
+		return "", nil, errTimeout
+	})
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+
+	//交易所可能已经受理,重试会超过上限
+	_, _, err = g.Withdraw(req, func() (string, []byte, error) {
+		t.Fatal("retry should be blocked by the daily cap")
+		return "", nil, nil
+	})
+	if err == nil {
+		t.Fatal("expected cap exceeded error")
+	}
+}
+
+func TestWithdrawGuard_Refund(t *testing.T) {
+	g := newTestGuard(func(model.WithdrawRequest) error { return nil })
+	req := model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 60}
+
+	id, _, err := g.Withdraw(req, func() (string, []byte, error) { return "wd-1", nil, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g.Refund("unknown")
+	if spent := g.DailySpent("USDT"); spent != 60 {
+		t.Fatalf("spent = %v after unknown refund, want 60", spent)
+	}
+
+	g.Refund(id)
+	if spent := g.DailySpent("USDT"); spent != 0 {
+		t.Fatalf("spent = %v after refund, want 0", spent)
+	}
+
+	//重复撤销不会重复返还
+	g.Refund(id)
+	if spent := g.DailySpent("USDT"); spent != 0 {
+		t.Fatalf("spent = %v after second refund, want 0", spent)
+	}
+}
+
+func TestWithdrawGuard_DayRollover(t *testing.T) {
+	now := time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC)
+	g := newTestGuard(func(model.WithdrawRequest) error { return nil })
+	g.now = func() time.Time { return now }
+	req := model.WithdrawRequest{Coin: "USDT", Address: "addr1", Amount: 80}
+
+	id, _, err := g.Withdraw(req, func() (string, []byte, error) { return "wd-1", nil, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = g.Withdraw(req, func() (string, []byte, error) { return "wd-2", nil, nil }); err == nil {
+		t.Fatal("expected cap exceeded error on the same day")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if spent := g.DailySpent("USDT"); spent != 0 {
+		t.Fatalf("spent = %v on the next day, want 0", spent)
+	}
+
+	if _, _, err = g.Withdraw(req, func() (string, []byte, error) { return "wd-3", nil, nil }); err != nil {
+		t.Fatal(err)
+	}
+
+	//撤销前一天的提币不影响当天的额度
+	g.Refund(id)
+	if spent := g.DailySpent("USDT"); spent != 80 {
+		t.Fatalf("spent = %v after refunding yesterday's withdraw, want 80", spent)
+	}
+}
@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"context"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"net/url"
+)
+
+const instrumentationName = "github.com/nntaoli-project/goex/v2"
+
+// 交易对可能使用的参数名,用于设置span的symbol属性
+var symbolKeys = []string{"symbol", "instId", "contract_code", "instFamily", "symbols"}
+
+func tracer() trace.Tracer {
+	return otel.GetTracerProvider().Tracer(instrumentationName)
+}
+
+// Start 开始一次http请求的span,在交易所的DoAuthRequest/DoNoAuthRequest中调用,
+// ctx为options.WithContext设置的父context,params中没有交易对时从reqUrl的query中查找。
+// 未通过otel.SetTracerProvider设置TracerProvider时不会产生任何span
+func Start(ctx context.Context, exchange, method, reqUrl string, params *url.Values) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var (
+		endpoint string
+		query    url.Values
+	)
+	if u, err := url.Parse(reqUrl); err == nil {
+		endpoint, query = u.Path, u.Query()
+	}
+
+	attrs := []attribute.KeyValue{
+		attribute.String("exchange", exchange),
+		attribute.String("http.method", method),
+		attribute.String("http.route", endpoint),
+	}
+	if symbol := symbolOf(params, query); symbol != "" {
+		attrs = append(attrs, attribute.String("symbol", symbol))
+	}
+
+	return tracer().Start(ctx, exchange+" "+method+" "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// StartStep 开始api调用中的一个步骤(sign/http/unmarshal)的子span
+func StartStep(ctx context.Context, step string) trace.Span {
+	_, span := tracer().Start(ctx, step)
+	return span
+}
+
+// End 结束span,err不为nil时记录错误
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func symbolOf(params *url.Values, query url.Values) string {
+	candidates := []url.Values{query}
+	if params != nil {
+		candidates = []url.Values{*params, query}
+	}
+	for _, vals := range candidates {
+		for _, k := range symbolKeys {
+			if v := vals.Get(k); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
@@ -1,13 +1,20 @@
 package util
 
 import (
+	"crypto"
+	"crypto/ed25519"
 	"crypto/hmac"
 	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/sha512"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/pem"
+	"errors"
 )
 
 func MD5Sign(secret, params string) (string, error) {
@@ -86,3 +93,72 @@ func HmacSHA512Base64Sign(hmacKey string, data string) string {
 
 	return base64.StdEncoding.EncodeToString(hashHmacBytes)
 }
+
+// HmacSHA256SignBytes 与HmacSHA256Sign相同,secret使用[]byte,方便调用方用完后清零
+func HmacSHA256SignBytes(secret []byte, params string) (string, error) {
+	mac := hmac.New(sha256.New, secret)
+	_, err := mac.Write([]byte(params))
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// HmacSHA256Base64SignBytes 与HmacSHA256Base64Sign相同,secret使用[]byte
+func HmacSHA256Base64SignBytes(secret []byte, params string) (string, error) {
+	mac := hmac.New(sha256.New, secret)
+	_, err := mac.Write([]byte(params))
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// RsaSHA256Base64Sign 使用PEM格式(PKCS#8或PKCS#1)的RSA私钥签名
+func RsaSHA256Base64Sign(pemKey []byte, params string) (string, error) {
+	key, err := parsePemPrivateKey(pemKey)
+	if err != nil {
+		return "", err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return "", errors.New("private key is not rsa key")
+	}
+
+	hashed := sha256.Sum256([]byte(params))
+	sign, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sign), nil
+}
+
+// Ed25519Base64Sign 使用PEM格式(PKCS#8)的Ed25519私钥签名
+func Ed25519Base64Sign(pemKey []byte, params string) (string, error) {
+	key, err := parsePemPrivateKey(pemKey)
+	if err != nil {
+		return "", err
+	}
+
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return "", errors.New("private key is not ed25519 key")
+	}
+
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(edKey, []byte(params))), nil
+}
+
+func parsePemPrivateKey(pemKey []byte) (interface{}, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New("decode pem private key error")
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	return x509.ParsePKCS8PrivateKey(block.Bytes)
+}
@@ -14,7 +14,7 @@ import (
 	"strings"
 )
 
-//FloatToString 保留的小数点位数,去除末尾多余的0(StripTrailingZeros)
+// FloatToString 保留的小数点位数,去除末尾多余的0(StripTrailingZeros)
 func FloatToString(v float64, n int) string {
 	ret := strconv.FormatFloat(v, 'f', n, 64)
 	return strconv.FormatFloat(cast.ToFloat64(ret), 'f', -1, 64) //StripTrailingZeros